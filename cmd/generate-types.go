@@ -2,12 +2,17 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
+	"go/format"
 	"io"
 	"math"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
@@ -16,6 +21,8 @@ import (
 	"github.com/segmentio/kafka-go/protocol"
 )
 
+//go:generate go run . -schema-dir ../kafka-schemas -out-dir ../protocol
+
 type VersionRange struct {
 	Min int
 	Max int
@@ -82,7 +89,24 @@ func (v *VersionRange) UnmarshalJSON(data []byte) error {
 type FieldType string
 
 func (f FieldType) CanBeCompact() bool {
-	return f == "string" || f == "bytes"
+	return f == "string" || f == "bytes" || strings.HasPrefix(string(f), "[]")
+}
+
+// ZeroValue returns the Go zero-value literal for f. It is used to give a
+// nullable field a well-defined value before it is decoded, so that a wire
+// value of "null" reliably produces this type's zero value rather than
+// whatever the field happened to hold beforehand.
+func (f FieldType) ZeroValue() string {
+	switch {
+	case f == "bytes", strings.HasPrefix(string(f), "[]"):
+		return "nil"
+	case f == "string":
+		return `""`
+	case f == "bool":
+		return "false"
+	default:
+		return "0"
+	}
 }
 
 type Field struct {
@@ -211,6 +235,22 @@ func (fs Fields) normalizeVersions(max int, flexibleRange VersionRange) {
 	}
 }
 
+// partitionTagged splits fs into the fields that are encoded positionally
+// (in field-declaration order) and the fields that carry an explicit schema
+// "tag" id. Tagged fields are never part of the positional wire layout; they
+// only ever travel in the per-version tag buffer on flexible versions,
+// identified by their tag id rather than their position.
+func (fs Fields) partitionTagged() (positional, tagged Fields) {
+	for _, f := range fs {
+		if f.Tag != nil {
+			tagged = append(tagged, f)
+		} else {
+			positional = append(positional, f)
+		}
+	}
+	return positional, tagged
+}
+
 type APIType struct {
 	APIKey           protocol.ApiKey `json:"apiKey"`
 	Type             string          `json:"type"`
@@ -242,6 +282,16 @@ func (a APIType) GenerateTags() {
 	a.Fields.GenerateTags(a.ValidVersions, a.FlexibleVersions)
 }
 
+// normalizeName turns a schema field or message name into the exported Go
+// identifier the generator uses for it.
+func normalizeName(name string) string {
+	name = strings.Title(name)
+	name = strings.ReplaceAll(name, "Id", "ID")
+	name = strings.ReplaceAll(name, "Ms", "MS")
+	name = strings.TrimPrefix(name, "[]")
+	return name
+}
+
 var helpers = template.FuncMap{
 	"normalizeType": func(t string) string {
 		if t == "bytes" {
@@ -249,13 +299,7 @@ var helpers = template.FuncMap{
 		}
 		return t
 	},
-	"normalizeName": func(name string) string {
-		name = strings.Title(name)
-		name = strings.ReplaceAll(name, "Id", "ID")
-		name = strings.ReplaceAll(name, "Ms", "MS")
-		name = strings.TrimPrefix(name, "[]")
-		return name
-	},
+	"normalizeName": normalizeName,
 	"backTick": func(s string) string {
 		return fmt.Sprintf("`%s`", s)
 	},
@@ -272,46 +316,160 @@ type {{ printf "%s" .Type | normalizeName }} struct {
 }
 
 {{ if or (eq "request" .Type) (eq "response" .Type )}}
-func (r *{{ printf "%s" .Type | normalizeName }})  ApiKey() protocol.ApiKey { return protcol.{{ .APIKey }} }
+func (r *{{ printf "%s" .Type | normalizeName }}) ApiKey() protocol.ApiKey { return protocol.{{ .APIKey }} }
+
+func (r *{{ printf "%s" .Type | normalizeName }}) MinVersion() int16 { return {{ .ValidVersions.Min }} }
+
+func (r *{{ printf "%s" .Type | normalizeName }}) MaxVersion() int16 { return {{ .ValidVersions.Max }} }
 {{ end }}
 `
 
+// apiGroup holds the request and response APITypes that make up a single
+// Kafka API (e.g. "JoinGroup"), so that both halves can be emitted into one
+// generated file and registered with the protocol package together.
+type apiGroup struct {
+	name     string
+	request  *APIType
+	response *APIType
+}
+
+// apiName derives the API name (e.g. "JoinGroup") that a message belongs to
+// by stripping its "Request"/"Response" suffix.
+func apiName(messageName string) string {
+	messageName = strings.TrimSuffix(messageName, "Request")
+	messageName = strings.TrimSuffix(messageName, "Response")
+	return messageName
+}
+
 func main() {
+	schemaDir := flag.String("schema-dir", "./schema", "directory of Kafka message JSON schemas to read")
+	outDir := flag.String("out-dir", "./protocol", "directory under which generated protocol/<api> packages are written")
+	flag.Parse()
+
+	if err := generate(*schemaDir, *outDir); err != nil {
+		panic(err)
+	}
+}
+
+// generate reads every Kafka message JSON schema in schemaDir, groups the
+// request/response pair for each API, and writes the generated Go types for
+// each API to outDir.
+func generate(schemaDir, outDir string) error {
 	req := template.New("requests").Funcs(helpers)
 	reqTemplate, err := req.Parse(requestTemplate)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	scanner := bufio.NewScanner(os.Stdin)
-	r := csReader{
-		scanner: scanner,
+
+	schemaFiles, err := filepath.Glob(filepath.Join(schemaDir, "*.json"))
+	if err != nil {
+		return err
 	}
+	sort.Strings(schemaFiles)
 
-	dec := json.NewDecoder(&r)
+	groups := map[string]*apiGroup{}
+	var order []string
 
-	for {
-		var t APIType
+	for _, path := range schemaFiles {
+		t, err := decodeSchema(path)
+		if err != nil {
+			return fmt.Errorf("decoding %s: %w", path, err)
+		}
 
-		if err := dec.Decode(&t); err != nil {
-			if errors.Is(err, io.EOF) {
-				return
-			}
+		name := apiName(t.Name)
+		g, ok := groups[name]
+		if !ok {
+			g = &apiGroup{name: name}
+			groups[name] = g
+			order = append(order, name)
+		}
+		switch t.Type {
+		case "request":
+			g.request = t
+		case "response":
+			g.response = t
+		default:
+			return fmt.Errorf("%s: unsupported top-level schema type %q", path, t.Type)
+		}
+	}
+
+	for _, name := range order {
+		if err := writeAPIGroup(outDir, groups[name], reqTemplate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeSchema reads and decodes a single Kafka message JSON schema file,
+// tolerating the "//" line comments the upstream schemas use.
+func decodeSchema(path string) (*APIType, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csReader{scanner: bufio.NewScanner(f)}
+	dec := json.NewDecoder(&r)
 
-			panic(err)
+	var t APIType
+	if err := dec.Decode(&t); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("empty schema")
 		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+// writeAPIGroup renders the request and response types for one API into
+// protocol/<lower(name)>/zz_generated_<lower(name)>.go, registering both with
+// the central protocol registry so that (*Client).roundTrip can dispatch
+// them.
+func writeAPIGroup(outDir string, g *apiGroup, reqTemplate *template.Template) error {
+	pkg := strings.ToLower(g.name)
+	dir := filepath.Join(outDir, pkg)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
 
-		t.GenerateTags()
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by cmd/generate-types. DO NOT EDIT.\n\npackage %s\n\nimport \"github.com/segmentio/kafka-go/protocol\"\n", pkg)
 
+	for _, t := range []*APIType{g.request, g.response} {
+		if t == nil {
+			continue
+		}
+		// Tags were already generated once in APIType.UnmarshalJSON;
+		// calling GenerateTags again here would append a second,
+		// overlapping set of version segments onto the ones already
+		// computed for fields whose compact/nullable state actually
+		// changes across versions.
 		for _, field := range t.Fields {
-			if err := renderField(os.Stdout, field, reqTemplate); err != nil {
-				panic(err)
+			if err := renderField(&buf, field, reqTemplate); err != nil {
+				return err
 			}
 		}
-		if err := reqTemplate.Execute(os.Stdout, t); err != nil {
-			panic(err)
+		if err := reqTemplate.Execute(&buf, t); err != nil {
+			return err
 		}
+		// The loop above only emits size/writeTo/readFrom for fields that
+		// are themselves structs; the top-level Request/Response type needs
+		// the same methods generated for its own fields.
+		writeCodec(&buf, normalizeName(t.Type), t.Fields, t.FlexibleVersions)
+	}
+
+	if g.request != nil && g.response != nil {
+		fmt.Fprintf(&buf, "\nfunc init() { protocol.Register(&Request{}, &Response{}) }\n")
+	}
 
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting generated source for %s: %w", g.name, err)
 	}
+
+	return os.WriteFile(filepath.Join(dir, "zz_generated_"+pkg+".go"), out, 0o644)
 }
 
 func renderField(w io.Writer, f Field, templates *template.Template) error {
@@ -326,7 +484,240 @@ func renderField(w io.Writer, f Field, templates *template.Template) error {
 		}
 	}
 
-	return templates.Execute(w, f)
+	if err := templates.Execute(w, f); err != nil {
+		return err
+	}
+
+	flex := VersionRange{}
+	if f.FlexibleVersions != nil {
+		flex = *f.FlexibleVersions
+	}
+	writeCodec(w, normalizeName(string(f.Type)), f.Fields, flex)
+	return nil
+}
+
+// codecNames returns the sizeof/write/read helper names used to encode or
+// decode a single field for the given tag segment, honoring whether the
+// segment is a struct array, a primitive array, or a scalar, and for
+// scalars whether it is compact and/or nullable at that segment.
+func (f Field) codecNames(tag *Tag) (sizeofFn, writeFn, readFn string) {
+	switch {
+	case f.NeedsType():
+		if tag.Compact {
+			return "sizeofCompactArray", "writeCompactArray", "readCompactArrayWith"
+		}
+		return "sizeofArray", "writeArray", "readArrayWith"
+
+	case strings.HasPrefix(string(f.Type), "[]"):
+		base := strings.Title(strings.TrimPrefix(string(f.Type), "[]")) + "Array"
+		if tag.Compact {
+			return "sizeofCompact" + base, "writeCompact" + base, "readCompact" + base
+		}
+		return "sizeof" + base, "write" + base, "read" + base
+
+	default:
+		base := strings.Title(string(f.Type))
+		switch {
+		case tag.Compact && tag.Nullable:
+			return "sizeofNullableCompact" + base, "writeNullableCompact" + base, "readNullableCompact" + base
+		case tag.Compact:
+			return "sizeofCompact" + base, "writeCompact" + base, "readCompact" + base
+		case tag.Nullable:
+			return "sizeofNullable" + base, "writeNullable" + base, "readNullable" + base
+		default:
+			return "sizeof" + base, "write" + base, "read" + base
+		}
+	}
+}
+
+// writeCodec emits size(version int16) int32, writeTo(wb *writeBuffer,
+// version int16), and readFrom(r *bufio.Reader, size int, version int16)
+// (int, error) methods for typeName, with one version-guarded branch per
+// Tag segment so a single generated type correctly encodes every version in
+// ValidVersions, including switching between the classic and compact wire
+// formats on flexible versions.
+//
+// Fields with no explicit schema "tag" id are encoded positionally. Fields
+// that do carry a tag id are never part of the positional layout; they are
+// only ever present in the per-version tag buffer on flexible versions, so
+// they are emitted by emitTaggedSize/emitTaggedWrite/emitTaggedRead instead,
+// keyed by their tag id rather than their position. When a type is flexible
+// but tags no fields, the bare tag-buffer marker is emitted as before.
+func writeCodec(w io.Writer, typeName string, fields Fields, flexibleVersions VersionRange) {
+	positional, tagged := fields.partitionTagged()
+
+	fmt.Fprintf(w, "\nfunc (t *%s) size(version int16) (n int32) {\n", typeName)
+	for _, f := range positional {
+		emitSize(w, f)
+	}
+	if !flexibleVersions.IsNone() {
+		if len(tagged) == 0 {
+			fmt.Fprintf(w, "\tif version >= %d && version <= %d {\n\t\tn += sizeofTagBuffer()\n\t}\n", flexibleVersions.Min, flexibleVersions.Max)
+		} else {
+			emitTaggedSize(w, tagged, flexibleVersions)
+		}
+	}
+	fmt.Fprintf(w, "\treturn n\n}\n")
+
+	fmt.Fprintf(w, "\nfunc (t *%s) writeTo(wb *writeBuffer, version int16) {\n", typeName)
+	for _, f := range positional {
+		emitWrite(w, f)
+	}
+	if !flexibleVersions.IsNone() {
+		if len(tagged) == 0 {
+			fmt.Fprintf(w, "\tif version >= %d && version <= %d {\n\t\twb.writeTagBuffer()\n\t}\n", flexibleVersions.Min, flexibleVersions.Max)
+		} else {
+			emitTaggedWrite(w, tagged, flexibleVersions)
+		}
+	}
+	fmt.Fprintf(w, "}\n")
+
+	fmt.Fprintf(w, "\nfunc (t *%s) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {\n\tremain = size\n", typeName)
+	for _, f := range positional {
+		emitRead(w, f)
+	}
+	if !flexibleVersions.IsNone() {
+		if len(tagged) == 0 {
+			fmt.Fprintf(w, "\tif version >= %d && version <= %d {\n\t\tif remain, err = readTagBuffer(r, remain); err != nil {\n\t\t\treturn\n\t\t}\n\t}\n", flexibleVersions.Min, flexibleVersions.Max)
+		} else {
+			emitTaggedRead(w, tagged, flexibleVersions)
+		}
+	}
+	fmt.Fprintf(w, "\treturn\n}\n")
+}
+
+// emitTaggedSize emits the tag-buffer contribution of size() for a type's
+// tagged fields: one sizeofTaggedField call per (field, tag segment) present
+// at the version being sized, plus the varint tag count itself. Like
+// positional fields, a tagged field's own StructTag segments (already
+// computed by Field.GenerateTag) supply the version ranges and the
+// compact/nullable codec to use for each of them.
+func emitTaggedSize(w io.Writer, tagged Fields, flex VersionRange) {
+	fmt.Fprintf(w, "\tif version >= %d && version <= %d {\n", flex.Min, flex.Max)
+	fmt.Fprintf(w, "\t\tvar numTags int\n")
+	for _, f := range tagged {
+		name := normalizeName(f.Name)
+		for _, tag := range f.StructTag {
+			sizeofFn, _, _ := f.codecNames(tag)
+			sizeExpr := fmt.Sprintf("%s(t.%s)", sizeofFn, name)
+			if f.NeedsType() {
+				sizeExpr = fmt.Sprintf("%s(len(t.%s), func(i int) int32 { return t.%s[i].size(version) })", sizeofFn, name, name)
+			}
+			fmt.Fprintf(w, "\t\tif version >= %d && version <= %d {\n\t\t\tnumTags++\n\t\t\tn += sizeofTaggedField(%d, %s)\n\t\t}\n",
+				tag.StartVersion, tag.EndVersion, *f.Tag, sizeExpr)
+		}
+	}
+	fmt.Fprintf(w, "\t\tn += sizeofTagCount(numTags)\n\t}\n")
+}
+
+// emitTaggedWrite emits the tag-buffer contribution of writeTo() for a
+// type's tagged fields: the tag count, followed by one writeTaggedField call
+// per (field, tag segment) present at the version being written.
+func emitTaggedWrite(w io.Writer, tagged Fields, flex VersionRange) {
+	fmt.Fprintf(w, "\tif version >= %d && version <= %d {\n", flex.Min, flex.Max)
+	fmt.Fprintf(w, "\t\tvar numTags int\n")
+	for _, f := range tagged {
+		for _, tag := range f.StructTag {
+			fmt.Fprintf(w, "\t\tif version >= %d && version <= %d {\n\t\t\tnumTags++\n\t\t}\n", tag.StartVersion, tag.EndVersion)
+		}
+	}
+	fmt.Fprintf(w, "\t\twb.writeTagCount(numTags)\n")
+	for _, f := range tagged {
+		name := normalizeName(f.Name)
+		for _, tag := range f.StructTag {
+			sizeofFn, writeFn, _ := f.codecNames(tag)
+			sizeExpr := fmt.Sprintf("%s(t.%s)", sizeofFn, name)
+			writeStmt := fmt.Sprintf("wb.%s(t.%s)", writeFn, name)
+			if f.NeedsType() {
+				sizeExpr = fmt.Sprintf("%s(len(t.%s), func(i int) int32 { return t.%s[i].size(version) })", sizeofFn, name, name)
+				writeStmt = fmt.Sprintf("wb.%s(len(t.%s), func(i int) { t.%s[i].writeTo(wb, version) })", writeFn, name, name)
+			}
+			fmt.Fprintf(w, "\t\tif version >= %d && version <= %d {\n\t\t\twb.writeTaggedField(%d, %s, func() { %s })\n\t\t}\n",
+				tag.StartVersion, tag.EndVersion, *f.Tag, sizeExpr, writeStmt)
+		}
+	}
+	fmt.Fprintf(w, "\t}\n")
+}
+
+// emitTaggedRead emits the tag-buffer contribution of readFrom() for a
+// type's tagged fields: a dispatch table keyed by tag id, handed to
+// readTaggedFields so that each recognized tag is decoded into its field and
+// every other tag present on the wire is skipped. A tagged field is only
+// ever present in one compact/nullable combination at a time (tag buffers
+// only exist on flexible versions), so its first StructTag segment supplies
+// the codec to dispatch to.
+func emitTaggedRead(w io.Writer, tagged Fields, flex VersionRange) {
+	fmt.Fprintf(w, "\tif version >= %d && version <= %d {\n", flex.Min, flex.Max)
+	fmt.Fprintf(w, "\t\thandlers := map[int]func(r *bufio.Reader, size int) (int, error){\n")
+	for _, f := range tagged {
+		name := normalizeName(f.Name)
+		elemType := normalizeName(string(f.Type))
+		tag := f.StructTag[0]
+		_, _, readFn := f.codecNames(tag)
+		if f.NeedsType() {
+			fmt.Fprintf(w, "\t\t\t%d: func(r *bufio.Reader, size int) (int, error) {\n", *f.Tag)
+			fmt.Fprintf(w, "\t\t\t\tfn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {\n")
+			fmt.Fprintf(w, "\t\t\t\t\tvar item %s\n", elemType)
+			fmt.Fprintf(w, "\t\t\t\t\tif fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {\n\t\t\t\t\t\treturn\n\t\t\t\t\t}\n")
+			fmt.Fprintf(w, "\t\t\t\t\tt.%s = append(t.%s, item)\n\t\t\t\t\treturn\n\t\t\t\t}\n", name, name)
+			fmt.Fprintf(w, "\t\t\t\treturn %s(r, size, fn)\n\t\t\t},\n", readFn)
+		} else {
+			fmt.Fprintf(w, "\t\t\t%d: func(r *bufio.Reader, size int) (int, error) { return %s(r, size, &t.%s) },\n", *f.Tag, readFn, name)
+		}
+	}
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t\tif remain, err = readTaggedFields(r, remain, handlers); err != nil {\n\t\t\treturn\n\t\t}\n")
+	fmt.Fprintf(w, "\t}\n")
+}
+
+func emitSize(w io.Writer, f Field) {
+	name := normalizeName(f.Name)
+	for _, tag := range f.StructTag {
+		sizeofFn, _, _ := f.codecNames(tag)
+		if f.NeedsType() {
+			fmt.Fprintf(w, "\tif version >= %d && version <= %d {\n\t\tn += %s(len(t.%s), func(i int) int32 { return t.%s[i].size(version) })\n\t}\n",
+				tag.StartVersion, tag.EndVersion, sizeofFn, name, name)
+		} else {
+			fmt.Fprintf(w, "\tif version >= %d && version <= %d {\n\t\tn += %s(t.%s)\n\t}\n",
+				tag.StartVersion, tag.EndVersion, sizeofFn, name)
+		}
+	}
+}
+
+func emitWrite(w io.Writer, f Field) {
+	name := normalizeName(f.Name)
+	for _, tag := range f.StructTag {
+		_, writeFn, _ := f.codecNames(tag)
+		if f.NeedsType() {
+			fmt.Fprintf(w, "\tif version >= %d && version <= %d {\n\t\twb.%s(len(t.%s), func(i int) { t.%s[i].writeTo(wb, version) })\n\t}\n",
+				tag.StartVersion, tag.EndVersion, writeFn, name, name)
+		} else {
+			fmt.Fprintf(w, "\tif version >= %d && version <= %d {\n\t\twb.%s(t.%s)\n\t}\n",
+				tag.StartVersion, tag.EndVersion, writeFn, name)
+		}
+	}
+}
+
+func emitRead(w io.Writer, f Field) {
+	name := normalizeName(f.Name)
+	elemType := normalizeName(string(f.Type))
+	for _, tag := range f.StructTag {
+		_, _, readFn := f.codecNames(tag)
+		fmt.Fprintf(w, "\tif version >= %d && version <= %d {\n", tag.StartVersion, tag.EndVersion)
+		if f.NeedsType() {
+			fmt.Fprintf(w, "\t\tfn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {\n")
+			fmt.Fprintf(w, "\t\t\tvar item %s\n", elemType)
+			fmt.Fprintf(w, "\t\t\tif fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {\n\t\t\t\treturn\n\t\t\t}\n")
+			fmt.Fprintf(w, "\t\t\tt.%s = append(t.%s, item)\n\t\t\treturn\n\t\t}\n", name, name)
+			fmt.Fprintf(w, "\t\tif remain, err = %s(r, remain, fn); err != nil {\n\t\t\treturn\n\t\t}\n", readFn)
+		} else {
+			if tag.Nullable {
+				fmt.Fprintf(w, "\t\tt.%s = %s\n", name, f.Type.ZeroValue())
+			}
+			fmt.Fprintf(w, "\t\tif remain, err = %s(r, remain, &t.%s); err != nil {\n\t\t\treturn\n\t\t}\n", readFn, name)
+		}
+		fmt.Fprintf(w, "\t}\n")
+	}
 }
 
 type csReader struct {
@@ -3,6 +3,8 @@ package main
 import (
 	"encoding/json"
 	"math"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 )
@@ -35,3 +37,29 @@ func TestVersionRangeUnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+// TestGenerateGoldenJoinGroup regenerates the JoinGroup API from its schema
+// files and diffs the result against a checked-in expected output, so that
+// drift between the Kafka message schemas and the generator is caught in CI
+// rather than surfacing as a runtime protocol bug.
+func TestGenerateGoldenJoinGroup(t *testing.T) {
+	outDir := t.TempDir()
+
+	if err := generate("testdata/schema", outDir); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(outDir, "joingroup", "zz_generated_joingroup.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := os.ReadFile(filepath.Join("testdata", "golden", "joingroup", "zz_generated_joingroup.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != string(want) {
+		t.Fatalf("generated JoinGroup types do not match testdata/golden/joingroup/zz_generated_joingroup.go\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,141 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// ConsumerGroupConfig configures a ConsumerGroup.
+type ConsumerGroupConfig struct {
+	// Address of the kafka broker to send requests to.
+	Addr net.Addr
+
+	// ID of the group to join.
+	ID string
+
+	// GroupInstanceID, if set, registers this member as a static member
+	// under KIP-345: the coordinator remembers it across a disconnect and
+	// only removes it once SessionTimeout elapses without a heartbeat,
+	// instead of triggering a rebalance as soon as the connection drops.
+	// Leave it empty for ordinary dynamic membership.
+	GroupInstanceID string
+
+	// SessionTimeout is how long the coordinator waits for a heartbeat
+	// before considering this member dead.
+	SessionTimeout time.Duration
+
+	// RebalanceTimeout is how long the coordinator waits for this member to
+	// rejoin when the group is rebalancing.
+	RebalanceTimeout time.Duration
+
+	// ProtocolType is the unique name for the class of protocols
+	// implemented by the group, e.g. "consumer".
+	ProtocolType string
+
+	// Protocols is the list of group protocols this member supports, in
+	// preference order.
+	Protocols []GroupProtocol
+}
+
+// ConsumerGroup manages this client's membership in a single consumer
+// group: joining, heartbeating to stay alive, and leaving. It does not
+// perform partition assignment or message fetching.
+type ConsumerGroup struct {
+	config ConsumerGroupConfig
+	client *Client
+
+	mu           sync.Mutex
+	memberID     string
+	generationID int32
+}
+
+// NewConsumerGroup creates a ConsumerGroup that uses client to talk to the
+// group coordinator at config.Addr.
+func NewConsumerGroup(client *Client, config ConsumerGroupConfig) *ConsumerGroup {
+	return &ConsumerGroup{client: client, config: config}
+}
+
+// Join registers this client as a member of the group. If config.GroupInstanceID
+// was previously used to join this group and the session timeout has not
+// elapsed, the coordinator rejoins it to its existing generation rather
+// than forcing a rebalance.
+func (g *ConsumerGroup) Join(ctx context.Context) (*JoinGroupResponse, error) {
+	g.mu.Lock()
+	memberID := g.memberID
+	g.mu.Unlock()
+
+	res, err := g.client.JoinGroup(ctx, &JoinGroupRequest{
+		Addr:             g.config.Addr,
+		GroupID:          g.config.ID,
+		MemberID:         memberID,
+		GroupInstanceID:  g.config.GroupInstanceID,
+		SessionTimeout:   g.config.SessionTimeout,
+		RebalanceTimeout: g.config.RebalanceTimeout,
+		ProtocolType:     g.config.ProtocolType,
+		Protocols:        g.config.Protocols,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("kafka.(*ConsumerGroup).Join: %w", err)
+	}
+
+	if res.Error != nil {
+		// MemberIDRequired carries the MemberID the broker wants us to
+		// retry with on the next Join, instead of an empty one.
+		if res.Error == MemberIDRequired {
+			g.mu.Lock()
+			g.memberID = res.MemberID
+			g.mu.Unlock()
+		}
+		return res, nil
+	}
+
+	g.mu.Lock()
+	g.memberID = res.MemberID
+	g.generationID = int32(res.GenerationID)
+	g.mu.Unlock()
+
+	return res, nil
+}
+
+// Heartbeat keeps this member alive between rebalances. Callers using a
+// GroupInstanceID should treat a FencedInstanceID response error as fatal:
+// it means another member has since registered with the same
+// GroupInstanceID, so this one must stop rather than rejoin.
+func (g *ConsumerGroup) Heartbeat(ctx context.Context) (*HeartbeatResponse, error) {
+	g.mu.Lock()
+	memberID, generationID := g.memberID, g.generationID
+	g.mu.Unlock()
+
+	res, err := g.client.Heartbeat(ctx, &HeartbeatRequest{
+		Addr:            g.config.Addr,
+		GroupID:         g.config.ID,
+		GenerationID:    generationID,
+		MemberID:        memberID,
+		GroupInstanceID: g.config.GroupInstanceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("kafka.(*ConsumerGroup).Heartbeat: %w", err)
+	}
+
+	return res, nil
+}
+
+// Leave removes this member from the group immediately, identifying it by
+// its GroupInstanceID when one is configured so the coordinator removes the
+// right static member even if MemberID has since changed underneath it.
+func (g *ConsumerGroup) Leave(ctx context.Context) (*LeaveGroupResponse, error) {
+	g.mu.Lock()
+	memberID := g.memberID
+	g.mu.Unlock()
+
+	return g.client.LeaveGroup(ctx, &LeaveGroupRequest{
+		Addr:    g.config.Addr,
+		GroupID: g.config.ID,
+		Members: []LeaveGroupPlanMember{
+			{ID: memberID, GroupInstanceID: g.config.GroupInstanceID},
+		},
+	})
+}
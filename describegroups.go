@@ -0,0 +1,130 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/segmentio/kafka-go/protocol/describegroups"
+)
+
+// DescribeGroupsRequest is the request structure for the DescribeGroups
+// function.
+type DescribeGroupsRequest struct {
+	// Address of the kafka broker to send the request to.
+	Addr net.Addr
+
+	// GroupIDs is a slice of group IDs to get details for.
+	GroupIDs []string
+}
+
+// DescribeGroupsResponse is the response structure for the DescribeGroups
+// function.
+type DescribeGroupsResponse struct {
+	// Throttle is how long the client should wait before sending requests
+	// again.
+	Throttle time.Duration
+
+	// Groups is a slice of details for the requested groups.
+	Groups []DescribeGroupsResponseGroup
+}
+
+// DescribeGroupsResponseGroup contains the response details for a single
+// group.
+type DescribeGroupsResponseGroup struct {
+	// Error is set to a non-nil value including the code and message if a
+	// top-level error was encountered when requesting details for this
+	// group.
+	Error error
+
+	// GroupID is the ID of the group.
+	GroupID string
+
+	// GroupState is the state of the group.
+	GroupState string
+
+	// ProtocolType is the type of protocol being used for the group.
+	ProtocolType string
+
+	// Protocol is the name of the protocol being used for the group.
+	Protocol string
+
+	// Members is a slice of members in the group.
+	Members []DescribeGroupsResponseMember
+
+	// AuthorizedOperations is a bitfield that represents the operations that
+	// the client is allowed to perform on this group.
+	AuthorizedOperations int32
+}
+
+// DescribeGroupsResponseMember contains the response details for a single
+// group member.
+type DescribeGroupsResponseMember struct {
+	// MemberID assigned by the group coordinator.
+	MemberID string
+
+	// GroupInstanceID is the static membership instance ID of the member,
+	// populated from DescribeGroups v4+ (KIP-345). It is empty for dynamic
+	// members.
+	GroupInstanceID string
+
+	// ClientID is the ID of the client that the group member is using.
+	ClientID string
+
+	// ClientHost is the host of the client that the group member is
+	// connecting from.
+	ClientHost string
+
+	// MemberMetadata contains the metadata associated with this group
+	// member.
+	MemberMetadata []byte
+
+	// MemberAssignments contains the partition assignments for this group
+	// member.
+	MemberAssignments []byte
+}
+
+// DescribeGroups calls kafka's DescribeGroup API, returning the state of the
+// groups specified.
+func (c *Client) DescribeGroups(ctx context.Context, req *DescribeGroupsRequest) (*DescribeGroupsResponse, error) {
+	m, err := c.roundTrip(ctx, req.Addr, &describegroups.Request{
+		GroupIDs: req.GroupIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("kafka.(*Client).DescribeGroups: %w", err)
+	}
+
+	r := m.(*describegroups.Response)
+
+	res := &DescribeGroupsResponse{
+		Throttle: makeDuration(r.ThrottleTimeMS),
+		Groups:   make([]DescribeGroupsResponseGroup, 0, len(r.Groups)),
+	}
+
+	for _, group := range r.Groups {
+		members := make([]DescribeGroupsResponseMember, 0, len(group.Members))
+		for _, member := range group.Members {
+			members = append(members, DescribeGroupsResponseMember{
+				MemberID:          member.MemberID,
+				GroupInstanceID:   member.GroupInstanceID,
+				ClientID:          member.ClientID,
+				ClientHost:        member.ClientHost,
+				MemberMetadata:    member.MemberMetadata,
+				MemberAssignments: member.MemberAssignment,
+			})
+		}
+
+		res.Groups = append(res.Groups, DescribeGroupsResponseGroup{
+			Error:                makeError(group.ErrorCode, ""),
+			GroupID:              group.GroupID,
+			GroupState:           group.GroupState,
+			ProtocolType:         group.ProtocolType,
+			Protocol:             group.ProtocolData,
+			Members:              members,
+			AuthorizedOperations: group.AuthorizedOperations,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,51 @@
+package kafka
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/segmentio/kafka-go/protocol"
+	"github.com/segmentio/kafka-go/protocol/describegroups"
+)
+
+func TestClientDescribeGroups(t *testing.T) {
+	client := &Client{
+		Transport: roundTripFunc(func(ctx context.Context, addr net.Addr, req protocol.Message) (protocol.Message, error) {
+			r, ok := req.(*describegroups.Request)
+			if !ok {
+				t.Fatalf("unexpected request type %T", req)
+			}
+			if len(r.GroupIDs) != 1 || r.GroupIDs[0] != "group-1" {
+				t.Fatalf("unexpected GroupIDs: %v", r.GroupIDs)
+			}
+
+			return &describegroups.Response{
+				Groups: []describegroups.ResponseGroup{
+					{
+						GroupID:    "group-1",
+						GroupState: "Stable",
+						Members: []describegroups.ResponseMember{
+							{MemberID: "member-1", GroupInstanceID: "instance-1"},
+						},
+					},
+				},
+			}, nil
+		}),
+	}
+
+	res, err := client.DescribeGroups(context.Background(), &DescribeGroupsRequest{
+		GroupIDs: []string{"group-1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Groups) != 1 {
+		t.Fatalf("expected 1 group, got %d", len(res.Groups))
+	}
+	members := res.Groups[0].Members
+	if len(members) != 1 || members[0].GroupInstanceID != "instance-1" {
+		t.Fatalf("GroupInstanceID did not round-trip: %+v", members)
+	}
+}
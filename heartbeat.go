@@ -0,0 +1,74 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/segmentio/kafka-go/protocol/heartbeat"
+)
+
+// HeartbeatRequest is the request structure for the Heartbeat function.
+type HeartbeatRequest struct {
+	// Address of the kafka broker to send the request to.
+	Addr net.Addr
+
+	// GroupID of the group to heartbeat.
+	GroupID string
+
+	// GenerationID of the group.
+	GenerationID int32
+
+	// MemberID assigned to the member in the group.
+	MemberID string
+
+	// GroupInstanceID is a unique identifier for a member within its group,
+	// as introduced by KIP-345 static membership. A member that sets
+	// GroupInstanceID is not removed from the group when it misses a
+	// heartbeat window or disconnects; it is only removed once it fails to
+	// heartbeat for the full session timeout, which avoids a rebalance on a
+	// transient restart or network blip.
+	GroupInstanceID string
+}
+
+// HeartbeatResponse is the response structure for the Heartbeat function.
+type HeartbeatResponse struct {
+	// Error is set to a non-nil value including the code and message if a
+	// top-level error was encountered when making the request. Callers
+	// relying on static membership should check for FencedInstanceID, which
+	// indicates another member has taken over this GroupInstanceID and the
+	// client should stop rather than attempt to rejoin, and
+	// MemberIDRequired, which indicates the client must rejoin with the
+	// MemberID returned on the response before heartbeating again.
+	Error error
+
+	// Throttle is how long the client should wait before sending requests
+	// again.
+	Throttle time.Duration
+}
+
+// Heartbeat sends a heartbeat to the broker to indicate that the consumer
+// member specified by GroupID and MemberID is still alive.
+func (c *Client) Heartbeat(ctx context.Context, req *HeartbeatRequest) (*HeartbeatResponse, error) {
+	heartbeatReq := heartbeat.Request{
+		GroupID:         req.GroupID,
+		GenerationID:    req.GenerationID,
+		MemberID:        req.MemberID,
+		GroupInstanceID: req.GroupInstanceID,
+	}
+
+	m, err := c.roundTrip(ctx, req.Addr, &heartbeatReq)
+	if err != nil {
+		return nil, fmt.Errorf("kafka.(*Client).Heartbeat: %w", err)
+	}
+
+	r := m.(*heartbeat.Response)
+
+	res := &HeartbeatResponse{
+		Error:    makeError(r.ErrorCode, ""),
+		Throttle: makeDuration(r.ThrottleTimeMS),
+	}
+
+	return res, nil
+}
@@ -0,0 +1,162 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/segmentio/kafka-go/protocol/incrementalalterconfigs"
+)
+
+// IncrementalAlterConfigsRequest is the request structure for the
+// IncrementalAlterConfigs function, introduced by KIP-339 to allow adding to,
+// subtracting from, or setting individual config entries on a resource
+// without having to first read back and resend its entire config.
+type IncrementalAlterConfigsRequest struct {
+	// Address of the kafka broker to send the request to.
+	Addr net.Addr
+
+	// Resources is a list of resources to update configs for.
+	Resources []IncrementalAlterConfigsRequestResource
+
+	// ValidateOnly indicates whether the request should be validated without
+	// actually applying the config changes.
+	ValidateOnly bool
+}
+
+// ResourceType identifies the kind of resource a config operation applies
+// to, as defined by Kafka's ConfigResource.Type.
+type ResourceType int8
+
+const (
+	// ResourceTypeUnknown is returned for a resource type the client does
+	// not recognize.
+	ResourceTypeUnknown ResourceType = 0
+
+	// ResourceTypeTopic identifies a topic's configs.
+	ResourceTypeTopic ResourceType = 2
+
+	// ResourceTypeBroker identifies a broker's configs.
+	ResourceTypeBroker ResourceType = 4
+
+	// ResourceTypeBrokerLogger identifies a broker logger's configs.
+	ResourceTypeBrokerLogger ResourceType = 8
+)
+
+type IncrementalAlterConfigsRequestResource struct {
+	// ResourceType is the type of resource to update.
+	ResourceType ResourceType
+
+	// ResourceName is the name of the resource to update.
+	ResourceName string
+
+	// Configs is a list of config entries to add, subtract, or set.
+	Configs []IncrementalAlterConfigsRequestConfig
+}
+
+type IncrementalAlterConfigsRequestConfig struct {
+	// Name is the configuration key to change.
+	Name string
+
+	// Value is the configuration value to apply; its meaning depends on
+	// ConfigOperation.
+	Value string
+
+	// ConfigOperation indicates how Value should be applied to the existing
+	// config entry.
+	ConfigOperation ConfigOperation
+}
+
+// ConfigOperation is the operation to apply to a config entry as part of an
+// IncrementalAlterConfigs request.
+type ConfigOperation int8
+
+const (
+	// ConfigOperationSet sets the value of the config entry, overwriting any
+	// existing value.
+	ConfigOperationSet ConfigOperation = 0
+
+	// ConfigOperationDelete reverts the config entry to its default value.
+	ConfigOperationDelete ConfigOperation = 1
+
+	// ConfigOperationAppend appends the value to an existing list-type
+	// config entry.
+	ConfigOperationAppend ConfigOperation = 2
+
+	// ConfigOperationSubtract removes the value from an existing list-type
+	// config entry.
+	ConfigOperationSubtract ConfigOperation = 3
+)
+
+// IncrementalAlterConfigsResponse is the response structure for the
+// IncrementalAlterConfigs function.
+type IncrementalAlterConfigsResponse struct {
+	// Throttle is how long the client should wait before sending requests
+	// again.
+	Throttle time.Duration
+
+	// Resources contains the per-resource results of the request.
+	Resources []IncrementalAlterConfigsResponseResource
+}
+
+type IncrementalAlterConfigsResponseResource struct {
+	// Error is set to a non-nil value including the code and message if the
+	// config for this resource could not be altered.
+	Error error
+
+	// ResourceType is the type of resource that was updated.
+	ResourceType ResourceType
+
+	// ResourceName is the name of the resource that was updated.
+	ResourceName string
+}
+
+// IncrementalAlterConfigs incrementally alters configs on one or more
+// resources, applying only the requested config operations rather than
+// replacing the resource's entire config.
+func (c *Client) IncrementalAlterConfigs(ctx context.Context, req *IncrementalAlterConfigsRequest) (*IncrementalAlterConfigsResponse, error) {
+	resources := make([]incrementalalterconfigs.RequestResource, 0, len(req.Resources))
+
+	for _, resource := range req.Resources {
+		configs := make([]incrementalalterconfigs.RequestConfig, 0, len(resource.Configs))
+		for _, config := range resource.Configs {
+			configs = append(configs, incrementalalterconfigs.RequestConfig{
+				Name:            config.Name,
+				Value:           config.Value,
+				ConfigOperation: int8(config.ConfigOperation),
+			})
+		}
+
+		resources = append(resources, incrementalalterconfigs.RequestResource{
+			ResourceType: int8(resource.ResourceType),
+			ResourceName: resource.ResourceName,
+			Configs:      configs,
+		})
+	}
+
+	m, err := c.roundTrip(ctx, req.Addr, &incrementalalterconfigs.Request{
+		Resources:    resources,
+		ValidateOnly: req.ValidateOnly,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("kafka.(*Client).IncrementalAlterConfigs: %w", err)
+	}
+
+	r := m.(*incrementalalterconfigs.Response)
+
+	res := &IncrementalAlterConfigsResponse{
+		Throttle:  makeDuration(r.ThrottleTimeMS),
+		Resources: make([]IncrementalAlterConfigsResponseResource, 0, len(r.Responses)),
+	}
+
+	for _, resp := range r.Responses {
+		res.Resources = append(res.Resources, IncrementalAlterConfigsResponseResource{
+			Error:        makeError(resp.ErrorCode, resp.ErrorMessage),
+			ResourceType: ResourceType(resp.ResourceType),
+			ResourceName: resp.ResourceName,
+		})
+	}
+
+	return res, nil
+}
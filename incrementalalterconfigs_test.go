@@ -0,0 +1,56 @@
+package kafka
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/segmentio/kafka-go/protocol"
+	"github.com/segmentio/kafka-go/protocol/incrementalalterconfigs"
+)
+
+func TestClientIncrementalAlterConfigs(t *testing.T) {
+	client := &Client{
+		Transport: roundTripFunc(func(ctx context.Context, addr net.Addr, req protocol.Message) (protocol.Message, error) {
+			r, ok := req.(*incrementalalterconfigs.Request)
+			if !ok {
+				t.Fatalf("unexpected request type %T", req)
+			}
+			if !r.ValidateOnly {
+				t.Fatal("expected ValidateOnly to round-trip as true")
+			}
+			if len(r.Resources) != 1 || r.Resources[0].Configs[0].ConfigOperation != int8(ConfigOperationAppend) {
+				t.Fatalf("config operation did not round-trip: %+v", r.Resources)
+			}
+
+			return &incrementalalterconfigs.Response{
+				Responses: []incrementalalterconfigs.ResponseResource{
+					{ResourceType: r.Resources[0].ResourceType, ResourceName: r.Resources[0].ResourceName},
+				},
+			}, nil
+		}),
+	}
+
+	res, err := client.IncrementalAlterConfigs(context.Background(), &IncrementalAlterConfigsRequest{
+		ValidateOnly: true,
+		Resources: []IncrementalAlterConfigsRequestResource{
+			{
+				ResourceType: ResourceTypeTopic,
+				ResourceName: "topic-1",
+				Configs: []IncrementalAlterConfigsRequestConfig{
+					{Name: "retention.ms", Value: "1000", ConfigOperation: ConfigOperationAppend},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Resources) != 1 || res.Resources[0].ResourceName != "topic-1" {
+		t.Fatalf("unexpected result: %+v", res.Resources)
+	}
+	if res.Resources[0].Error != nil {
+		t.Fatalf("unexpected error: %v", res.Resources[0].Error)
+	}
+}
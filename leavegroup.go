@@ -0,0 +1,101 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/segmentio/kafka-go/protocol/leavegroup"
+)
+
+// LeaveGroupRequest is the request structure for the LeaveGroup function.
+type LeaveGroupRequest struct {
+	// Address of the kafka broker to send the request to.
+	Addr net.Addr
+
+	// GroupID of the group to leave.
+	GroupID string
+
+	// Members is the list of members to remove from the group. A member may
+	// be identified either by its MemberID or, for a static member added
+	// under KIP-345, by its GroupInstanceID; setting GroupInstanceID allows
+	// a specific static member to be removed from the group without forcing
+	// every other member through a rebalance.
+	Members []LeaveGroupPlanMember
+}
+
+type LeaveGroupPlanMember struct {
+	// ID is the member ID assigned by the group coordinator.
+	ID string
+
+	// GroupInstanceID is the static membership instance ID of the member to
+	// remove, if any.
+	GroupInstanceID string
+}
+
+// LeaveGroupResponse is the response structure for the LeaveGroup function.
+type LeaveGroupResponse struct {
+	// Error is set to a non-nil value including the code and message if a
+	// top-level error was encountered when making the request.
+	Error error
+
+	// Throttle is how long the client should wait before sending requests
+	// again.
+	Throttle time.Duration
+
+	// Members is a list of members who left the group.
+	Members []LeaveGroupResponseMember
+}
+
+type LeaveGroupResponseMember struct {
+	// ID is the member ID assigned by the group coordinator.
+	ID string
+
+	// GroupInstanceID is the static membership instance ID of the member, if
+	// any.
+	GroupInstanceID string
+
+	// Error is set if the broker could not remove this member, for example
+	// FencedInstanceID if the GroupInstanceID no longer matches the member
+	// that is currently active in the group.
+	Error error
+}
+
+// LeaveGroup removes one or more members from a consumer group.
+func (c *Client) LeaveGroup(ctx context.Context, req *LeaveGroupRequest) (*LeaveGroupResponse, error) {
+	leaveGroup := leavegroup.Request{
+		GroupID: req.GroupID,
+		Members: make([]leavegroup.RequestMember, 0, len(req.Members)),
+	}
+
+	for _, member := range req.Members {
+		leaveGroup.Members = append(leaveGroup.Members, leavegroup.RequestMember{
+			MemberID:        member.ID,
+			GroupInstanceID: member.GroupInstanceID,
+		})
+	}
+
+	m, err := c.roundTrip(ctx, req.Addr, &leaveGroup)
+	if err != nil {
+		return nil, fmt.Errorf("kafka.(*Client).LeaveGroup: %w", err)
+	}
+
+	r := m.(*leavegroup.Response)
+
+	res := &LeaveGroupResponse{
+		Error:    makeError(r.ErrorCode, ""),
+		Throttle: makeDuration(r.ThrottleTimeMS),
+		Members:  make([]LeaveGroupResponseMember, 0, len(r.Members)),
+	}
+
+	for _, member := range r.Members {
+		res.Members = append(res.Members, LeaveGroupResponseMember{
+			ID:              member.MemberID,
+			GroupInstanceID: member.GroupInstanceID,
+			Error:           makeError(member.ErrorCode, ""),
+		})
+	}
+
+	return res, nil
+}
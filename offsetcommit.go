@@ -0,0 +1,134 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/segmentio/kafka-go/protocol/offsetcommit"
+)
+
+// OffsetCommitRequest is the request structure for the OffsetCommit
+// function.
+type OffsetCommitRequest struct {
+	// Address of the kafka broker to send the request to.
+	Addr net.Addr
+
+	// GroupID of the group to commit offsets for.
+	GroupID string
+
+	// GenerationID of the group.
+	GenerationID int32
+
+	// MemberID assigned to the member in the group.
+	MemberID string
+
+	// GroupInstanceID is a unique identifier for a member within its group,
+	// as introduced by KIP-345 static membership.
+	GroupInstanceID string
+
+	// RetentionTimeMillis holds the time period in milliseconds after which
+	// the offset will be discarded, or -1 to use the broker default.
+	RetentionTimeMillis int64
+
+	// Topics is a mapping of topic names to the partitions to commit
+	// offsets for.
+	Topics map[string][]OffsetCommitPartition
+}
+
+type OffsetCommitPartition struct {
+	// Partition is the ID of the partition to commit an offset for.
+	Partition int
+
+	// Offset is the offset to commit.
+	Offset int64
+
+	// LeaderEpoch is the epoch of the partition leader that produced the
+	// record at Offset, as introduced by KIP-320. Setting it lets the
+	// coordinator reject a commit derived from a stale leader, instead of
+	// silently committing an offset the client computed from data a more
+	// recent leader has already truncated.
+	LeaderEpoch int32
+
+	// Metadata holds any client-provided metadata for the offset commit.
+	Metadata string
+}
+
+// OffsetCommitResponse is the response structure for the OffsetCommit
+// function.
+type OffsetCommitResponse struct {
+	// Throttle is how long the client should wait before sending requests
+	// again.
+	Throttle time.Duration
+
+	// Topics is a mapping of topic names to the per-partition results of the
+	// request.
+	Topics map[string][]OffsetCommitPartitionResponse
+}
+
+type OffsetCommitPartitionResponse struct {
+	// Partition is the ID of the partition the offset was committed for.
+	Partition int
+
+	// Error is set to a non-nil value including the code and message if the
+	// broker rejected the commit, for example FencedInstanceID if this
+	// member's GroupInstanceID has since been reassigned, or an error
+	// indicating the supplied LeaderEpoch is stale.
+	Error error
+}
+
+// OffsetCommit commits a set of partition offsets on behalf of a consumer
+// group member.
+func (c *Client) OffsetCommit(ctx context.Context, req *OffsetCommitRequest) (*OffsetCommitResponse, error) {
+	topics := make([]offsetcommit.RequestTopic, 0, len(req.Topics))
+
+	for topic, partitions := range req.Topics {
+		partitionReqs := make([]offsetcommit.RequestPartition, 0, len(partitions))
+		for _, partition := range partitions {
+			partitionReqs = append(partitionReqs, offsetcommit.RequestPartition{
+				Partition:            int32(partition.Partition),
+				CommittedOffset:      partition.Offset,
+				CommittedLeaderEpoch: partition.LeaderEpoch,
+				CommittedMetadata:    partition.Metadata,
+			})
+		}
+
+		topics = append(topics, offsetcommit.RequestTopic{
+			Name:       topic,
+			Partitions: partitionReqs,
+		})
+	}
+
+	m, err := c.roundTrip(ctx, req.Addr, &offsetcommit.Request{
+		GroupID:         req.GroupID,
+		GenerationID:    req.GenerationID,
+		MemberID:        req.MemberID,
+		GroupInstanceID: req.GroupInstanceID,
+		RetentionTimeMS: req.RetentionTimeMillis,
+		Topics:          topics,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("kafka.(*Client).OffsetCommit: %w", err)
+	}
+
+	r := m.(*offsetcommit.Response)
+
+	res := &OffsetCommitResponse{
+		Throttle: makeDuration(r.ThrottleTimeMS),
+		Topics:   make(map[string][]OffsetCommitPartitionResponse, len(r.Topics)),
+	}
+
+	for _, topic := range r.Topics {
+		partitionResponses := make([]OffsetCommitPartitionResponse, 0, len(topic.Partitions))
+		for _, partition := range topic.Partitions {
+			partitionResponses = append(partitionResponses, OffsetCommitPartitionResponse{
+				Partition: int(partition.Partition),
+				Error:     makeError(partition.ErrorCode, ""),
+			})
+		}
+		res.Topics[topic.Name] = partitionResponses
+	}
+
+	return res, nil
+}
@@ -0,0 +1,57 @@
+package kafka
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/segmentio/kafka-go/protocol"
+	"github.com/segmentio/kafka-go/protocol/offsetcommit"
+)
+
+func TestClientOffsetCommit(t *testing.T) {
+	client := &Client{
+		Transport: roundTripFunc(func(ctx context.Context, addr net.Addr, req protocol.Message) (protocol.Message, error) {
+			r, ok := req.(*offsetcommit.Request)
+			if !ok {
+				t.Fatalf("unexpected request type %T", req)
+			}
+			if r.GroupInstanceID != "instance-1" {
+				t.Fatalf("expected GroupInstanceID %q, got %q", "instance-1", r.GroupInstanceID)
+			}
+			if len(r.Topics) != 1 || r.Topics[0].Partitions[0].CommittedLeaderEpoch != 7 {
+				t.Fatalf("leader epoch did not round-trip: %+v", r.Topics)
+			}
+
+			return &offsetcommit.Response{
+				Topics: []offsetcommit.ResponseTopic{
+					{
+						Name: "topic-1",
+						Partitions: []offsetcommit.ResponsePartition{
+							{Partition: 0, ErrorCode: 0},
+						},
+					},
+				},
+			}, nil
+		}),
+	}
+
+	res, err := client.OffsetCommit(context.Background(), &OffsetCommitRequest{
+		GroupID:         "group-1",
+		GroupInstanceID: "instance-1",
+		Topics: map[string][]OffsetCommitPartition{
+			"topic-1": {{Partition: 0, Offset: 10, LeaderEpoch: 7}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	partitions := res.Topics["topic-1"]
+	if len(partitions) != 1 {
+		t.Fatalf("expected 1 partition result, got %d", len(partitions))
+	}
+	if partitions[0].Error != nil {
+		t.Fatalf("unexpected error: %v", partitions[0].Error)
+	}
+}
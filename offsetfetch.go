@@ -0,0 +1,124 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/segmentio/kafka-go/protocol/offsetfetch"
+)
+
+// OffsetFetchRequest is the request structure for the OffsetFetch function.
+type OffsetFetchRequest struct {
+	// Address of the kafka broker to send the request to.
+	Addr net.Addr
+
+	// GroupID of the group to fetch offsets for.
+	GroupID string
+
+	// GroupInstanceID is a unique identifier for a member within its group,
+	// as introduced by KIP-345 static membership.
+	GroupInstanceID string
+
+	// Topics is a mapping of topic names to the partitions to fetch
+	// committed offsets for. A nil map fetches offsets for every partition
+	// the group has committed to.
+	Topics map[string][]int
+}
+
+// OffsetFetchResponse is the response structure for the OffsetFetch
+// function.
+type OffsetFetchResponse struct {
+	// Error is set to a non-nil value including the code and message if a
+	// top-level error was encountered when making the request.
+	Error error
+
+	// Throttle is how long the client should wait before sending requests
+	// again.
+	Throttle time.Duration
+
+	// Topics is a mapping of topic names to the per-partition results of the
+	// request.
+	Topics map[string][]OffsetFetchPartition
+}
+
+type OffsetFetchPartition struct {
+	// Partition is the ID of the partition the offset was fetched for.
+	Partition int
+
+	// CommittedOffset is the last committed offset for this partition, or -1
+	// if the group has not committed an offset for it.
+	CommittedOffset int64
+
+	// CommittedLeaderEpoch is the epoch of the partition leader in effect
+	// when CommittedOffset was committed, as introduced by KIP-320. Clients
+	// resuming from this offset should fence stale producers/consumers
+	// using this epoch rather than assume the leader has not changed since.
+	CommittedLeaderEpoch int32
+
+	// Metadata holds any client-provided metadata associated with the
+	// commit.
+	Metadata string
+
+	// Error is set to a non-nil value including the code and message if the
+	// broker could not return the offset for this partition.
+	Error error
+}
+
+// OffsetFetch fetches the last committed offsets for a consumer group.
+func (c *Client) OffsetFetch(ctx context.Context, req *OffsetFetchRequest) (*OffsetFetchResponse, error) {
+	// req.Topics == nil means "fetch offsets for every partition the group
+	// has committed to"; leave the protocol request's Topics nil too so
+	// that intent survives onto the wire instead of becoming "fetch
+	// offsets for zero topics".
+	var topics []offsetfetch.RequestTopic
+	if req.Topics != nil {
+		topics = make([]offsetfetch.RequestTopic, 0, len(req.Topics))
+
+		for topic, partitions := range req.Topics {
+			partitionIDs := make([]int32, 0, len(partitions))
+			for _, partition := range partitions {
+				partitionIDs = append(partitionIDs, int32(partition))
+			}
+
+			topics = append(topics, offsetfetch.RequestTopic{
+				Name:       topic,
+				Partitions: partitionIDs,
+			})
+		}
+	}
+
+	m, err := c.roundTrip(ctx, req.Addr, &offsetfetch.Request{
+		GroupID:         req.GroupID,
+		GroupInstanceID: req.GroupInstanceID,
+		Topics:          topics,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("kafka.(*Client).OffsetFetch: %w", err)
+	}
+
+	r := m.(*offsetfetch.Response)
+
+	res := &OffsetFetchResponse{
+		Error:    makeError(r.ErrorCode, ""),
+		Throttle: makeDuration(r.ThrottleTimeMS),
+		Topics:   make(map[string][]OffsetFetchPartition, len(r.Topics)),
+	}
+
+	for _, topic := range r.Topics {
+		partitions := make([]OffsetFetchPartition, 0, len(topic.Partitions))
+		for _, partition := range topic.Partitions {
+			partitions = append(partitions, OffsetFetchPartition{
+				Partition:            int(partition.Partition),
+				CommittedOffset:      partition.CommittedOffset,
+				CommittedLeaderEpoch: partition.CommittedLeaderEpoch,
+				Metadata:             partition.Metadata,
+				Error:                makeError(partition.ErrorCode, ""),
+			})
+		}
+		res.Topics[topic.Name] = partitions
+	}
+
+	return res, nil
+}
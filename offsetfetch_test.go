@@ -0,0 +1,73 @@
+package kafka
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/segmentio/kafka-go/protocol"
+	"github.com/segmentio/kafka-go/protocol/offsetfetch"
+)
+
+func TestClientOffsetFetch(t *testing.T) {
+	client := &Client{
+		Transport: roundTripFunc(func(ctx context.Context, addr net.Addr, req protocol.Message) (protocol.Message, error) {
+			r, ok := req.(*offsetfetch.Request)
+			if !ok {
+				t.Fatalf("unexpected request type %T", req)
+			}
+			if r.GroupInstanceID != "instance-1" {
+				t.Fatalf("expected GroupInstanceID %q, got %q", "instance-1", r.GroupInstanceID)
+			}
+
+			return &offsetfetch.Response{
+				Topics: []offsetfetch.ResponseTopic{
+					{
+						Name: "topic-1",
+						Partitions: []offsetfetch.ResponsePartition{
+							{Partition: 0, CommittedOffset: 42, CommittedLeaderEpoch: 3},
+						},
+					},
+				},
+			}, nil
+		}),
+	}
+
+	res, err := client.OffsetFetch(context.Background(), &OffsetFetchRequest{
+		GroupID:         "group-1",
+		GroupInstanceID: "instance-1",
+		Topics: map[string][]int{
+			"topic-1": {0},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	partitions := res.Topics["topic-1"]
+	if len(partitions) != 1 || partitions[0].CommittedOffset != 42 {
+		t.Fatalf("unexpected result: %+v", partitions)
+	}
+}
+
+// TestClientOffsetFetchNilTopicsFetchesEverything verifies that a nil Topics
+// map on the request keeps the protocol-level Topics nil too, rather than
+// silently becoming a request for zero topics.
+func TestClientOffsetFetchNilTopicsFetchesEverything(t *testing.T) {
+	client := &Client{
+		Transport: roundTripFunc(func(ctx context.Context, addr net.Addr, req protocol.Message) (protocol.Message, error) {
+			r, ok := req.(*offsetfetch.Request)
+			if !ok {
+				t.Fatalf("unexpected request type %T", req)
+			}
+			if r.Topics != nil {
+				t.Fatalf("expected nil Topics to fetch every partition, got %+v", r.Topics)
+			}
+			return &offsetfetch.Response{}, nil
+		}),
+	}
+
+	if _, err := client.OffsetFetch(context.Background(), &OffsetFetchRequest{GroupID: "group-1"}); err != nil {
+		t.Fatal(err)
+	}
+}
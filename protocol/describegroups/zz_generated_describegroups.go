@@ -0,0 +1,487 @@
+// Code generated by cmd/generate-types. DO NOT EDIT.
+
+package describegroups
+
+import "github.com/segmentio/kafka-go/protocol"
+
+type Request struct {
+	_ struct{} `kafka:"min=5,max=5,tag"`
+
+	GroupIDs []string `kafka:"min=0,max=4|min=5,max=5,compact"`
+}
+
+func (r *Request) ApiKey() protocol.ApiKey { return protocol.DescribeGroups }
+
+func (r *Request) MinVersion() int16 { return 0 }
+
+func (r *Request) MaxVersion() int16 { return 5 }
+
+func (t *Request) size(version int16) (n int32) {
+	if version >= 0 && version <= 4 {
+		n += sizeofStringArray(t.GroupIDs)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofCompactStringArray(t.GroupIDs)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *Request) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 4 {
+		wb.writeStringArray(t.GroupIDs)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeCompactStringArray(t.GroupIDs)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *Request) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 4 {
+		if remain, err = readStringArray(r, remain, &t.GroupIDs); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readCompactStringArray(r, remain, &t.GroupIDs); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type ResponseMember struct {
+	MemberID         string `kafka:"min=0,max=4|min=5,max=5,compact"`
+	GroupInstanceID  string `kafka:"min=4,max=4,nullable|min=5,max=5,compact,nullable"`
+	ClientID         string `kafka:"min=0,max=4|min=5,max=5,compact"`
+	ClientHost       string `kafka:"min=0,max=4|min=5,max=5,compact"`
+	MemberMetadata   []byte `kafka:"min=0,max=4|min=5,max=5,compact"`
+	MemberAssignment []byte `kafka:"min=0,max=4|min=5,max=5,compact"`
+}
+
+func (t *ResponseMember) size(version int16) (n int32) {
+	if version >= 0 && version <= 4 {
+		n += sizeofString(t.MemberID)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofCompactString(t.MemberID)
+	}
+	if version >= 4 && version <= 4 {
+		n += sizeofNullableString(t.GroupInstanceID)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofNullableCompactString(t.GroupInstanceID)
+	}
+	if version >= 0 && version <= 4 {
+		n += sizeofString(t.ClientID)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofCompactString(t.ClientID)
+	}
+	if version >= 0 && version <= 4 {
+		n += sizeofString(t.ClientHost)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofCompactString(t.ClientHost)
+	}
+	if version >= 0 && version <= 4 {
+		n += sizeofBytes(t.MemberMetadata)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofCompactBytes(t.MemberMetadata)
+	}
+	if version >= 0 && version <= 4 {
+		n += sizeofBytes(t.MemberAssignment)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofCompactBytes(t.MemberAssignment)
+	}
+	return n
+}
+
+func (t *ResponseMember) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 4 {
+		wb.writeString(t.MemberID)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeCompactString(t.MemberID)
+	}
+	if version >= 4 && version <= 4 {
+		wb.writeNullableString(t.GroupInstanceID)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeNullableCompactString(t.GroupInstanceID)
+	}
+	if version >= 0 && version <= 4 {
+		wb.writeString(t.ClientID)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeCompactString(t.ClientID)
+	}
+	if version >= 0 && version <= 4 {
+		wb.writeString(t.ClientHost)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeCompactString(t.ClientHost)
+	}
+	if version >= 0 && version <= 4 {
+		wb.writeBytes(t.MemberMetadata)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeCompactBytes(t.MemberMetadata)
+	}
+	if version >= 0 && version <= 4 {
+		wb.writeBytes(t.MemberAssignment)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeCompactBytes(t.MemberAssignment)
+	}
+}
+
+func (t *ResponseMember) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 4 {
+		if remain, err = readString(r, remain, &t.MemberID); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readCompactString(r, remain, &t.MemberID); err != nil {
+			return
+		}
+	}
+	if version >= 4 && version <= 4 {
+		t.GroupInstanceID = ""
+		if remain, err = readNullableString(r, remain, &t.GroupInstanceID); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		t.GroupInstanceID = ""
+		if remain, err = readNullableCompactString(r, remain, &t.GroupInstanceID); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 4 {
+		if remain, err = readString(r, remain, &t.ClientID); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readCompactString(r, remain, &t.ClientID); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 4 {
+		if remain, err = readString(r, remain, &t.ClientHost); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readCompactString(r, remain, &t.ClientHost); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 4 {
+		if remain, err = readBytes(r, remain, &t.MemberMetadata); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readCompactBytes(r, remain, &t.MemberMetadata); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 4 {
+		if remain, err = readBytes(r, remain, &t.MemberAssignment); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readCompactBytes(r, remain, &t.MemberAssignment); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type ResponseGroup struct {
+	_ struct{} `kafka:"min=5,max=5,tag"`
+
+	ErrorCode            int16            `kafka:"min=0,max=5"`
+	GroupID              string           `kafka:"min=0,max=4|min=5,max=5,compact"`
+	GroupState           string           `kafka:"min=0,max=4|min=5,max=5,compact"`
+	ProtocolType         string           `kafka:"min=0,max=4|min=5,max=5,compact"`
+	ProtocolData         string           `kafka:"min=0,max=4|min=5,max=5,compact"`
+	Members              []ResponseMember `kafka:"min=0,max=4|min=5,max=5,compact"`
+	AuthorizedOperations int32            `kafka:"min=3,max=5"`
+}
+
+func (t *ResponseGroup) size(version int16) (n int32) {
+	if version >= 0 && version <= 5 {
+		n += sizeofInt16(t.ErrorCode)
+	}
+	if version >= 0 && version <= 4 {
+		n += sizeofString(t.GroupID)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofCompactString(t.GroupID)
+	}
+	if version >= 0 && version <= 4 {
+		n += sizeofString(t.GroupState)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofCompactString(t.GroupState)
+	}
+	if version >= 0 && version <= 4 {
+		n += sizeofString(t.ProtocolType)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofCompactString(t.ProtocolType)
+	}
+	if version >= 0 && version <= 4 {
+		n += sizeofString(t.ProtocolData)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofCompactString(t.ProtocolData)
+	}
+	if version >= 0 && version <= 4 {
+		n += sizeofArray(len(t.Members), func(i int) int32 { return t.Members[i].size(version) })
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofCompactArray(len(t.Members), func(i int) int32 { return t.Members[i].size(version) })
+	}
+	if version >= 3 && version <= 5 {
+		n += sizeofInt32(t.AuthorizedOperations)
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *ResponseGroup) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 5 {
+		wb.writeInt16(t.ErrorCode)
+	}
+	if version >= 0 && version <= 4 {
+		wb.writeString(t.GroupID)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeCompactString(t.GroupID)
+	}
+	if version >= 0 && version <= 4 {
+		wb.writeString(t.GroupState)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeCompactString(t.GroupState)
+	}
+	if version >= 0 && version <= 4 {
+		wb.writeString(t.ProtocolType)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeCompactString(t.ProtocolType)
+	}
+	if version >= 0 && version <= 4 {
+		wb.writeString(t.ProtocolData)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeCompactString(t.ProtocolData)
+	}
+	if version >= 0 && version <= 4 {
+		wb.writeArray(len(t.Members), func(i int) { t.Members[i].writeTo(wb, version) })
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeCompactArray(len(t.Members), func(i int) { t.Members[i].writeTo(wb, version) })
+	}
+	if version >= 3 && version <= 5 {
+		wb.writeInt32(t.AuthorizedOperations)
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *ResponseGroup) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 5 {
+		if remain, err = readInt16(r, remain, &t.ErrorCode); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 4 {
+		if remain, err = readString(r, remain, &t.GroupID); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readCompactString(r, remain, &t.GroupID); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 4 {
+		if remain, err = readString(r, remain, &t.GroupState); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readCompactString(r, remain, &t.GroupState); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 4 {
+		if remain, err = readString(r, remain, &t.ProtocolType); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readCompactString(r, remain, &t.ProtocolType); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 4 {
+		if remain, err = readString(r, remain, &t.ProtocolData); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readCompactString(r, remain, &t.ProtocolData); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 4 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponseMember
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Members = append(t.Members, item)
+			return
+		}
+		if remain, err = readArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponseMember
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Members = append(t.Members, item)
+			return
+		}
+		if remain, err = readCompactArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 3 && version <= 5 {
+		if remain, err = readInt32(r, remain, &t.AuthorizedOperations); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type Response struct {
+	_ struct{} `kafka:"min=5,max=5,tag"`
+
+	ThrottleTimeMS int32           `kafka:"min=1,max=5"`
+	Groups         []ResponseGroup `kafka:"min=0,max=4|min=5,max=5,compact"`
+}
+
+func (r *Response) ApiKey() protocol.ApiKey { return protocol.DescribeGroups }
+
+func (r *Response) MinVersion() int16 { return 0 }
+
+func (r *Response) MaxVersion() int16 { return 5 }
+
+func (t *Response) size(version int16) (n int32) {
+	if version >= 1 && version <= 5 {
+		n += sizeofInt32(t.ThrottleTimeMS)
+	}
+	if version >= 0 && version <= 4 {
+		n += sizeofArray(len(t.Groups), func(i int) int32 { return t.Groups[i].size(version) })
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofCompactArray(len(t.Groups), func(i int) int32 { return t.Groups[i].size(version) })
+	}
+	if version >= 5 && version <= 5 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *Response) writeTo(wb *writeBuffer, version int16) {
+	if version >= 1 && version <= 5 {
+		wb.writeInt32(t.ThrottleTimeMS)
+	}
+	if version >= 0 && version <= 4 {
+		wb.writeArray(len(t.Groups), func(i int) { t.Groups[i].writeTo(wb, version) })
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeCompactArray(len(t.Groups), func(i int) { t.Groups[i].writeTo(wb, version) })
+	}
+	if version >= 5 && version <= 5 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *Response) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 1 && version <= 5 {
+		if remain, err = readInt32(r, remain, &t.ThrottleTimeMS); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 4 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponseGroup
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Groups = append(t.Groups, item)
+			return
+		}
+		if remain, err = readArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponseGroup
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Groups = append(t.Groups, item)
+			return
+		}
+		if remain, err = readCompactArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 5 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func init() { protocol.Register(&Request{}, &Response{}) }
@@ -0,0 +1,340 @@
+// Code generated by cmd/generate-types. DO NOT EDIT.
+
+package incrementalalterconfigs
+
+import "github.com/segmentio/kafka-go/protocol"
+
+type RequestConfig struct {
+	Name            string `kafka:"min=0,max=1,compact"`
+	ConfigOperation int8   `kafka:"min=0,max=1"`
+	Value           string `kafka:"min=0,max=1,compact,nullable"`
+}
+
+func (t *RequestConfig) size(version int16) (n int32) {
+	if version >= 0 && version <= 1 {
+		n += sizeofCompactString(t.Name)
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofInt8(t.ConfigOperation)
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofNullableCompactString(t.Value)
+	}
+	return n
+}
+
+func (t *RequestConfig) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 1 {
+		wb.writeCompactString(t.Name)
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeInt8(t.ConfigOperation)
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeNullableCompactString(t.Value)
+	}
+}
+
+func (t *RequestConfig) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 1 {
+		if remain, err = readCompactString(r, remain, &t.Name); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		if remain, err = readInt8(r, remain, &t.ConfigOperation); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		t.Value = ""
+		if remain, err = readNullableCompactString(r, remain, &t.Value); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type RequestResource struct {
+	_ struct{} `kafka:"min=0,max=1,tag"`
+
+	ResourceType int8            `kafka:"min=0,max=1"`
+	ResourceName string          `kafka:"min=0,max=1,compact"`
+	Configs      []RequestConfig `kafka:"min=0,max=1,compact"`
+}
+
+func (t *RequestResource) size(version int16) (n int32) {
+	if version >= 0 && version <= 1 {
+		n += sizeofInt8(t.ResourceType)
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofCompactString(t.ResourceName)
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofCompactArray(len(t.Configs), func(i int) int32 { return t.Configs[i].size(version) })
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *RequestResource) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 1 {
+		wb.writeInt8(t.ResourceType)
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeCompactString(t.ResourceName)
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeCompactArray(len(t.Configs), func(i int) { t.Configs[i].writeTo(wb, version) })
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *RequestResource) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 1 {
+		if remain, err = readInt8(r, remain, &t.ResourceType); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		if remain, err = readCompactString(r, remain, &t.ResourceName); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item RequestConfig
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Configs = append(t.Configs, item)
+			return
+		}
+		if remain, err = readCompactArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type Request struct {
+	_ struct{} `kafka:"min=0,max=1,tag"`
+
+	Resources    []RequestResource `kafka:"min=0,max=1,compact"`
+	ValidateOnly bool              `kafka:"min=0,max=1"`
+}
+
+func (r *Request) ApiKey() protocol.ApiKey { return protocol.IncrementalAlterConfigs }
+
+func (r *Request) MinVersion() int16 { return 0 }
+
+func (r *Request) MaxVersion() int16 { return 1 }
+
+func (t *Request) size(version int16) (n int32) {
+	if version >= 0 && version <= 1 {
+		n += sizeofCompactArray(len(t.Resources), func(i int) int32 { return t.Resources[i].size(version) })
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofBool(t.ValidateOnly)
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *Request) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 1 {
+		wb.writeCompactArray(len(t.Resources), func(i int) { t.Resources[i].writeTo(wb, version) })
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeBool(t.ValidateOnly)
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *Request) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 1 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item RequestResource
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Resources = append(t.Resources, item)
+			return
+		}
+		if remain, err = readCompactArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		if remain, err = readBool(r, remain, &t.ValidateOnly); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type ResponseResource struct {
+	_ struct{} `kafka:"min=0,max=1,tag"`
+
+	ErrorCode    int16  `kafka:"min=0,max=1"`
+	ErrorMessage string `kafka:"min=0,max=1,compact,nullable"`
+	ResourceType int8   `kafka:"min=0,max=1"`
+	ResourceName string `kafka:"min=0,max=1,compact"`
+}
+
+func (t *ResponseResource) size(version int16) (n int32) {
+	if version >= 0 && version <= 1 {
+		n += sizeofInt16(t.ErrorCode)
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofNullableCompactString(t.ErrorMessage)
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofInt8(t.ResourceType)
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofCompactString(t.ResourceName)
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *ResponseResource) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 1 {
+		wb.writeInt16(t.ErrorCode)
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeNullableCompactString(t.ErrorMessage)
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeInt8(t.ResourceType)
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeCompactString(t.ResourceName)
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *ResponseResource) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 1 {
+		if remain, err = readInt16(r, remain, &t.ErrorCode); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		t.ErrorMessage = ""
+		if remain, err = readNullableCompactString(r, remain, &t.ErrorMessage); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		if remain, err = readInt8(r, remain, &t.ResourceType); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		if remain, err = readCompactString(r, remain, &t.ResourceName); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type Response struct {
+	_ struct{} `kafka:"min=0,max=1,tag"`
+
+	ThrottleTimeMS int32              `kafka:"min=0,max=1"`
+	Responses      []ResponseResource `kafka:"min=0,max=1,compact"`
+}
+
+func (r *Response) ApiKey() protocol.ApiKey { return protocol.IncrementalAlterConfigs }
+
+func (r *Response) MinVersion() int16 { return 0 }
+
+func (r *Response) MaxVersion() int16 { return 1 }
+
+func (t *Response) size(version int16) (n int32) {
+	if version >= 0 && version <= 1 {
+		n += sizeofInt32(t.ThrottleTimeMS)
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofCompactArray(len(t.Responses), func(i int) int32 { return t.Responses[i].size(version) })
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *Response) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 1 {
+		wb.writeInt32(t.ThrottleTimeMS)
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeCompactArray(len(t.Responses), func(i int) { t.Responses[i].writeTo(wb, version) })
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *Response) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 1 {
+		if remain, err = readInt32(r, remain, &t.ThrottleTimeMS); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponseResource
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Responses = append(t.Responses, item)
+			return
+		}
+		if remain, err = readCompactArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func init() { protocol.Register(&Request{}, &Response{}) }
@@ -0,0 +1,555 @@
+// Code generated by cmd/generate-types. DO NOT EDIT.
+
+package offsetcommit
+
+import "github.com/segmentio/kafka-go/protocol"
+
+type RequestPartition struct {
+	Partition            int32  `kafka:"min=0,max=8"`
+	CommittedOffset      int64  `kafka:"min=0,max=8"`
+	CommittedLeaderEpoch int32  `kafka:"min=6,max=8"`
+	CommittedMetadata    string `kafka:"min=0,max=7,nullable|min=8,max=8,compact,nullable"`
+}
+
+func (t *RequestPartition) size(version int16) (n int32) {
+	if version >= 0 && version <= 8 {
+		n += sizeofInt32(t.Partition)
+	}
+	if version >= 0 && version <= 8 {
+		n += sizeofInt64(t.CommittedOffset)
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofInt32(t.CommittedLeaderEpoch)
+	}
+	if version >= 0 && version <= 7 {
+		n += sizeofNullableString(t.CommittedMetadata)
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofNullableCompactString(t.CommittedMetadata)
+	}
+	return n
+}
+
+func (t *RequestPartition) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 8 {
+		wb.writeInt32(t.Partition)
+	}
+	if version >= 0 && version <= 8 {
+		wb.writeInt64(t.CommittedOffset)
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeInt32(t.CommittedLeaderEpoch)
+	}
+	if version >= 0 && version <= 7 {
+		wb.writeNullableString(t.CommittedMetadata)
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeNullableCompactString(t.CommittedMetadata)
+	}
+}
+
+func (t *RequestPartition) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 8 {
+		if remain, err = readInt32(r, remain, &t.Partition); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 8 {
+		if remain, err = readInt64(r, remain, &t.CommittedOffset); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		if remain, err = readInt32(r, remain, &t.CommittedLeaderEpoch); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 7 {
+		t.CommittedMetadata = ""
+		if remain, err = readNullableString(r, remain, &t.CommittedMetadata); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		t.CommittedMetadata = ""
+		if remain, err = readNullableCompactString(r, remain, &t.CommittedMetadata); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type RequestTopic struct {
+	_ struct{} `kafka:"min=8,max=8,tag"`
+
+	Name       string             `kafka:"min=0,max=7|min=8,max=8,compact"`
+	Partitions []RequestPartition `kafka:"min=0,max=7|min=8,max=8,compact"`
+}
+
+func (t *RequestTopic) size(version int16) (n int32) {
+	if version >= 0 && version <= 7 {
+		n += sizeofString(t.Name)
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofCompactString(t.Name)
+	}
+	if version >= 0 && version <= 7 {
+		n += sizeofArray(len(t.Partitions), func(i int) int32 { return t.Partitions[i].size(version) })
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofCompactArray(len(t.Partitions), func(i int) int32 { return t.Partitions[i].size(version) })
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *RequestTopic) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 7 {
+		wb.writeString(t.Name)
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeCompactString(t.Name)
+	}
+	if version >= 0 && version <= 7 {
+		wb.writeArray(len(t.Partitions), func(i int) { t.Partitions[i].writeTo(wb, version) })
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeCompactArray(len(t.Partitions), func(i int) { t.Partitions[i].writeTo(wb, version) })
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *RequestTopic) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 7 {
+		if remain, err = readString(r, remain, &t.Name); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		if remain, err = readCompactString(r, remain, &t.Name); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 7 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item RequestPartition
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Partitions = append(t.Partitions, item)
+			return
+		}
+		if remain, err = readArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item RequestPartition
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Partitions = append(t.Partitions, item)
+			return
+		}
+		if remain, err = readCompactArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type Request struct {
+	_ struct{} `kafka:"min=8,max=8,tag"`
+
+	GroupID         string         `kafka:"min=0,max=7|min=8,max=8,compact"`
+	GenerationID    int32          `kafka:"min=1,max=8"`
+	MemberID        string         `kafka:"min=1,max=7|min=8,max=8,compact"`
+	GroupInstanceID string         `kafka:"min=7,max=7,nullable|min=8,max=8,compact,nullable"`
+	RetentionTimeMS int64          `kafka:"min=0,max=8"`
+	Topics          []RequestTopic `kafka:"min=0,max=7|min=8,max=8,compact"`
+}
+
+func (r *Request) ApiKey() protocol.ApiKey { return protocol.OffsetCommit }
+
+func (r *Request) MinVersion() int16 { return 0 }
+
+func (r *Request) MaxVersion() int16 { return 8 }
+
+func (t *Request) size(version int16) (n int32) {
+	if version >= 0 && version <= 7 {
+		n += sizeofString(t.GroupID)
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofCompactString(t.GroupID)
+	}
+	if version >= 1 && version <= 8 {
+		n += sizeofInt32(t.GenerationID)
+	}
+	if version >= 1 && version <= 7 {
+		n += sizeofString(t.MemberID)
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofCompactString(t.MemberID)
+	}
+	if version >= 7 && version <= 7 {
+		n += sizeofNullableString(t.GroupInstanceID)
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofNullableCompactString(t.GroupInstanceID)
+	}
+	if version >= 0 && version <= 8 {
+		n += sizeofInt64(t.RetentionTimeMS)
+	}
+	if version >= 0 && version <= 7 {
+		n += sizeofArray(len(t.Topics), func(i int) int32 { return t.Topics[i].size(version) })
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofCompactArray(len(t.Topics), func(i int) int32 { return t.Topics[i].size(version) })
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *Request) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 7 {
+		wb.writeString(t.GroupID)
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeCompactString(t.GroupID)
+	}
+	if version >= 1 && version <= 8 {
+		wb.writeInt32(t.GenerationID)
+	}
+	if version >= 1 && version <= 7 {
+		wb.writeString(t.MemberID)
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeCompactString(t.MemberID)
+	}
+	if version >= 7 && version <= 7 {
+		wb.writeNullableString(t.GroupInstanceID)
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeNullableCompactString(t.GroupInstanceID)
+	}
+	if version >= 0 && version <= 8 {
+		wb.writeInt64(t.RetentionTimeMS)
+	}
+	if version >= 0 && version <= 7 {
+		wb.writeArray(len(t.Topics), func(i int) { t.Topics[i].writeTo(wb, version) })
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeCompactArray(len(t.Topics), func(i int) { t.Topics[i].writeTo(wb, version) })
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *Request) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 7 {
+		if remain, err = readString(r, remain, &t.GroupID); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		if remain, err = readCompactString(r, remain, &t.GroupID); err != nil {
+			return
+		}
+	}
+	if version >= 1 && version <= 8 {
+		if remain, err = readInt32(r, remain, &t.GenerationID); err != nil {
+			return
+		}
+	}
+	if version >= 1 && version <= 7 {
+		if remain, err = readString(r, remain, &t.MemberID); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		if remain, err = readCompactString(r, remain, &t.MemberID); err != nil {
+			return
+		}
+	}
+	if version >= 7 && version <= 7 {
+		t.GroupInstanceID = ""
+		if remain, err = readNullableString(r, remain, &t.GroupInstanceID); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		t.GroupInstanceID = ""
+		if remain, err = readNullableCompactString(r, remain, &t.GroupInstanceID); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 8 {
+		if remain, err = readInt64(r, remain, &t.RetentionTimeMS); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 7 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item RequestTopic
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Topics = append(t.Topics, item)
+			return
+		}
+		if remain, err = readArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item RequestTopic
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Topics = append(t.Topics, item)
+			return
+		}
+		if remain, err = readCompactArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type ResponsePartition struct {
+	Partition int32 `kafka:"min=0,max=8"`
+	ErrorCode int16 `kafka:"min=0,max=8"`
+}
+
+func (t *ResponsePartition) size(version int16) (n int32) {
+	if version >= 0 && version <= 8 {
+		n += sizeofInt32(t.Partition)
+	}
+	if version >= 0 && version <= 8 {
+		n += sizeofInt16(t.ErrorCode)
+	}
+	return n
+}
+
+func (t *ResponsePartition) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 8 {
+		wb.writeInt32(t.Partition)
+	}
+	if version >= 0 && version <= 8 {
+		wb.writeInt16(t.ErrorCode)
+	}
+}
+
+func (t *ResponsePartition) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 8 {
+		if remain, err = readInt32(r, remain, &t.Partition); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 8 {
+		if remain, err = readInt16(r, remain, &t.ErrorCode); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type ResponseTopic struct {
+	_ struct{} `kafka:"min=8,max=8,tag"`
+
+	Name       string              `kafka:"min=0,max=7|min=8,max=8,compact"`
+	Partitions []ResponsePartition `kafka:"min=0,max=7|min=8,max=8,compact"`
+}
+
+func (t *ResponseTopic) size(version int16) (n int32) {
+	if version >= 0 && version <= 7 {
+		n += sizeofString(t.Name)
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofCompactString(t.Name)
+	}
+	if version >= 0 && version <= 7 {
+		n += sizeofArray(len(t.Partitions), func(i int) int32 { return t.Partitions[i].size(version) })
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofCompactArray(len(t.Partitions), func(i int) int32 { return t.Partitions[i].size(version) })
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *ResponseTopic) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 7 {
+		wb.writeString(t.Name)
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeCompactString(t.Name)
+	}
+	if version >= 0 && version <= 7 {
+		wb.writeArray(len(t.Partitions), func(i int) { t.Partitions[i].writeTo(wb, version) })
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeCompactArray(len(t.Partitions), func(i int) { t.Partitions[i].writeTo(wb, version) })
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *ResponseTopic) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 7 {
+		if remain, err = readString(r, remain, &t.Name); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		if remain, err = readCompactString(r, remain, &t.Name); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 7 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponsePartition
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Partitions = append(t.Partitions, item)
+			return
+		}
+		if remain, err = readArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponsePartition
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Partitions = append(t.Partitions, item)
+			return
+		}
+		if remain, err = readCompactArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type Response struct {
+	_ struct{} `kafka:"min=8,max=8,tag"`
+
+	ThrottleTimeMS int32           `kafka:"min=3,max=8"`
+	Topics         []ResponseTopic `kafka:"min=0,max=7|min=8,max=8,compact"`
+}
+
+func (r *Response) ApiKey() protocol.ApiKey { return protocol.OffsetCommit }
+
+func (r *Response) MinVersion() int16 { return 0 }
+
+func (r *Response) MaxVersion() int16 { return 8 }
+
+func (t *Response) size(version int16) (n int32) {
+	if version >= 3 && version <= 8 {
+		n += sizeofInt32(t.ThrottleTimeMS)
+	}
+	if version >= 0 && version <= 7 {
+		n += sizeofArray(len(t.Topics), func(i int) int32 { return t.Topics[i].size(version) })
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofCompactArray(len(t.Topics), func(i int) int32 { return t.Topics[i].size(version) })
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *Response) writeTo(wb *writeBuffer, version int16) {
+	if version >= 3 && version <= 8 {
+		wb.writeInt32(t.ThrottleTimeMS)
+	}
+	if version >= 0 && version <= 7 {
+		wb.writeArray(len(t.Topics), func(i int) { t.Topics[i].writeTo(wb, version) })
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeCompactArray(len(t.Topics), func(i int) { t.Topics[i].writeTo(wb, version) })
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *Response) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 3 && version <= 8 {
+		if remain, err = readInt32(r, remain, &t.ThrottleTimeMS); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 7 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponseTopic
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Topics = append(t.Topics, item)
+			return
+		}
+		if remain, err = readArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponseTopic
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Topics = append(t.Topics, item)
+			return
+		}
+		if remain, err = readCompactArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func init() { protocol.Register(&Request{}, &Response{}) }
@@ -0,0 +1,484 @@
+// Code generated by cmd/generate-types. DO NOT EDIT.
+
+package offsetfetch
+
+import "github.com/segmentio/kafka-go/protocol"
+
+type RequestTopic struct {
+	_ struct{} `kafka:"min=6,max=8,tag"`
+
+	Name       string  `kafka:"min=0,max=5|min=6,max=8,compact"`
+	Partitions []int32 `kafka:"min=0,max=5|min=6,max=8,compact"`
+}
+
+func (t *RequestTopic) size(version int16) (n int32) {
+	if version >= 0 && version <= 5 {
+		n += sizeofString(t.Name)
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofCompactString(t.Name)
+	}
+	if version >= 0 && version <= 5 {
+		n += sizeofInt32Array(t.Partitions)
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofCompactInt32Array(t.Partitions)
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *RequestTopic) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 5 {
+		wb.writeString(t.Name)
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeCompactString(t.Name)
+	}
+	if version >= 0 && version <= 5 {
+		wb.writeInt32Array(t.Partitions)
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeCompactInt32Array(t.Partitions)
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *RequestTopic) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 5 {
+		if remain, err = readString(r, remain, &t.Name); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		if remain, err = readCompactString(r, remain, &t.Name); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 5 {
+		if remain, err = readInt32Array(r, remain, &t.Partitions); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		if remain, err = readCompactInt32Array(r, remain, &t.Partitions); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type Request struct {
+	_ struct{} `kafka:"min=6,max=8,tag"`
+
+	GroupID         string         `kafka:"min=0,max=5|min=6,max=8,compact"`
+	GroupInstanceID string         `kafka:"min=8,max=8,compact,nullable"`
+	Topics          []RequestTopic `kafka:"min=0,max=1|min=2,max=5,nullable|min=6,max=8,compact,nullable"`
+}
+
+func (r *Request) ApiKey() protocol.ApiKey { return protocol.OffsetFetch }
+
+func (r *Request) MinVersion() int16 { return 0 }
+
+func (r *Request) MaxVersion() int16 { return 8 }
+
+func (t *Request) size(version int16) (n int32) {
+	if version >= 0 && version <= 5 {
+		n += sizeofString(t.GroupID)
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofCompactString(t.GroupID)
+	}
+	if version >= 8 && version <= 8 {
+		n += sizeofNullableCompactString(t.GroupInstanceID)
+	}
+	if version >= 0 && version <= 1 {
+		n += sizeofArray(len(t.Topics), func(i int) int32 { return t.Topics[i].size(version) })
+	}
+	if version >= 2 && version <= 5 {
+		n += sizeofArray(len(t.Topics), func(i int) int32 { return t.Topics[i].size(version) })
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofCompactArray(len(t.Topics), func(i int) int32 { return t.Topics[i].size(version) })
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *Request) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 5 {
+		wb.writeString(t.GroupID)
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeCompactString(t.GroupID)
+	}
+	if version >= 8 && version <= 8 {
+		wb.writeNullableCompactString(t.GroupInstanceID)
+	}
+	if version >= 0 && version <= 1 {
+		wb.writeArray(len(t.Topics), func(i int) { t.Topics[i].writeTo(wb, version) })
+	}
+	if version >= 2 && version <= 5 {
+		wb.writeArray(len(t.Topics), func(i int) { t.Topics[i].writeTo(wb, version) })
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeCompactArray(len(t.Topics), func(i int) { t.Topics[i].writeTo(wb, version) })
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *Request) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 5 {
+		if remain, err = readString(r, remain, &t.GroupID); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		if remain, err = readCompactString(r, remain, &t.GroupID); err != nil {
+			return
+		}
+	}
+	if version >= 8 && version <= 8 {
+		t.GroupInstanceID = ""
+		if remain, err = readNullableCompactString(r, remain, &t.GroupInstanceID); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 1 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item RequestTopic
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Topics = append(t.Topics, item)
+			return
+		}
+		if remain, err = readArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 2 && version <= 5 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item RequestTopic
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Topics = append(t.Topics, item)
+			return
+		}
+		if remain, err = readArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item RequestTopic
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Topics = append(t.Topics, item)
+			return
+		}
+		if remain, err = readCompactArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type ResponsePartition struct {
+	Partition            int32  `kafka:"min=0,max=8"`
+	CommittedOffset      int64  `kafka:"min=0,max=8"`
+	CommittedLeaderEpoch int32  `kafka:"min=5,max=8"`
+	Metadata             string `kafka:"min=0,max=5,nullable|min=6,max=8,compact,nullable"`
+	ErrorCode            int16  `kafka:"min=0,max=8"`
+}
+
+func (t *ResponsePartition) size(version int16) (n int32) {
+	if version >= 0 && version <= 8 {
+		n += sizeofInt32(t.Partition)
+	}
+	if version >= 0 && version <= 8 {
+		n += sizeofInt64(t.CommittedOffset)
+	}
+	if version >= 5 && version <= 8 {
+		n += sizeofInt32(t.CommittedLeaderEpoch)
+	}
+	if version >= 0 && version <= 5 {
+		n += sizeofNullableString(t.Metadata)
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofNullableCompactString(t.Metadata)
+	}
+	if version >= 0 && version <= 8 {
+		n += sizeofInt16(t.ErrorCode)
+	}
+	return n
+}
+
+func (t *ResponsePartition) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 8 {
+		wb.writeInt32(t.Partition)
+	}
+	if version >= 0 && version <= 8 {
+		wb.writeInt64(t.CommittedOffset)
+	}
+	if version >= 5 && version <= 8 {
+		wb.writeInt32(t.CommittedLeaderEpoch)
+	}
+	if version >= 0 && version <= 5 {
+		wb.writeNullableString(t.Metadata)
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeNullableCompactString(t.Metadata)
+	}
+	if version >= 0 && version <= 8 {
+		wb.writeInt16(t.ErrorCode)
+	}
+}
+
+func (t *ResponsePartition) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 8 {
+		if remain, err = readInt32(r, remain, &t.Partition); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 8 {
+		if remain, err = readInt64(r, remain, &t.CommittedOffset); err != nil {
+			return
+		}
+	}
+	if version >= 5 && version <= 8 {
+		if remain, err = readInt32(r, remain, &t.CommittedLeaderEpoch); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 5 {
+		t.Metadata = ""
+		if remain, err = readNullableString(r, remain, &t.Metadata); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		t.Metadata = ""
+		if remain, err = readNullableCompactString(r, remain, &t.Metadata); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 8 {
+		if remain, err = readInt16(r, remain, &t.ErrorCode); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type ResponseTopic struct {
+	_ struct{} `kafka:"min=6,max=8,tag"`
+
+	Name       string              `kafka:"min=0,max=5|min=6,max=8,compact"`
+	Partitions []ResponsePartition `kafka:"min=0,max=5|min=6,max=8,compact"`
+}
+
+func (t *ResponseTopic) size(version int16) (n int32) {
+	if version >= 0 && version <= 5 {
+		n += sizeofString(t.Name)
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofCompactString(t.Name)
+	}
+	if version >= 0 && version <= 5 {
+		n += sizeofArray(len(t.Partitions), func(i int) int32 { return t.Partitions[i].size(version) })
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofCompactArray(len(t.Partitions), func(i int) int32 { return t.Partitions[i].size(version) })
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *ResponseTopic) writeTo(wb *writeBuffer, version int16) {
+	if version >= 0 && version <= 5 {
+		wb.writeString(t.Name)
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeCompactString(t.Name)
+	}
+	if version >= 0 && version <= 5 {
+		wb.writeArray(len(t.Partitions), func(i int) { t.Partitions[i].writeTo(wb, version) })
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeCompactArray(len(t.Partitions), func(i int) { t.Partitions[i].writeTo(wb, version) })
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *ResponseTopic) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 0 && version <= 5 {
+		if remain, err = readString(r, remain, &t.Name); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		if remain, err = readCompactString(r, remain, &t.Name); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 5 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponsePartition
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Partitions = append(t.Partitions, item)
+			return
+		}
+		if remain, err = readArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponsePartition
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Partitions = append(t.Partitions, item)
+			return
+		}
+		if remain, err = readCompactArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+type Response struct {
+	_ struct{} `kafka:"min=6,max=8,tag"`
+
+	ThrottleTimeMS int32           `kafka:"min=3,max=8"`
+	Topics         []ResponseTopic `kafka:"min=0,max=5|min=6,max=8,compact"`
+	ErrorCode      int16           `kafka:"min=2,max=8"`
+}
+
+func (r *Response) ApiKey() protocol.ApiKey { return protocol.OffsetFetch }
+
+func (r *Response) MinVersion() int16 { return 0 }
+
+func (r *Response) MaxVersion() int16 { return 8 }
+
+func (t *Response) size(version int16) (n int32) {
+	if version >= 3 && version <= 8 {
+		n += sizeofInt32(t.ThrottleTimeMS)
+	}
+	if version >= 0 && version <= 5 {
+		n += sizeofArray(len(t.Topics), func(i int) int32 { return t.Topics[i].size(version) })
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofCompactArray(len(t.Topics), func(i int) int32 { return t.Topics[i].size(version) })
+	}
+	if version >= 2 && version <= 8 {
+		n += sizeofInt16(t.ErrorCode)
+	}
+	if version >= 6 && version <= 8 {
+		n += sizeofTagBuffer()
+	}
+	return n
+}
+
+func (t *Response) writeTo(wb *writeBuffer, version int16) {
+	if version >= 3 && version <= 8 {
+		wb.writeInt32(t.ThrottleTimeMS)
+	}
+	if version >= 0 && version <= 5 {
+		wb.writeArray(len(t.Topics), func(i int) { t.Topics[i].writeTo(wb, version) })
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeCompactArray(len(t.Topics), func(i int) { t.Topics[i].writeTo(wb, version) })
+	}
+	if version >= 2 && version <= 8 {
+		wb.writeInt16(t.ErrorCode)
+	}
+	if version >= 6 && version <= 8 {
+		wb.writeTagBuffer()
+	}
+}
+
+func (t *Response) readFrom(r *bufio.Reader, size int, version int16) (remain int, err error) {
+	remain = size
+	if version >= 3 && version <= 8 {
+		if remain, err = readInt32(r, remain, &t.ThrottleTimeMS); err != nil {
+			return
+		}
+	}
+	if version >= 0 && version <= 5 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponseTopic
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Topics = append(t.Topics, item)
+			return
+		}
+		if remain, err = readArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		fn := func(r *bufio.Reader, size int) (fnRemain int, fnErr error) {
+			var item ResponseTopic
+			if fnRemain, fnErr = (&item).readFrom(r, size, version); fnErr != nil {
+				return
+			}
+			t.Topics = append(t.Topics, item)
+			return
+		}
+		if remain, err = readCompactArrayWith(r, remain, fn); err != nil {
+			return
+		}
+	}
+	if version >= 2 && version <= 8 {
+		if remain, err = readInt16(r, remain, &t.ErrorCode); err != nil {
+			return
+		}
+	}
+	if version >= 6 && version <= 8 {
+		if remain, err = readTagBuffer(r, remain); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func init() { protocol.Register(&Request{}, &Response{}) }
@@ -0,0 +1,16 @@
+package kafka
+
+import (
+	"context"
+	"net"
+
+	"github.com/segmentio/kafka-go/protocol"
+)
+
+// roundTripFunc adapts a function to the RoundTripper interface so tests can
+// stub a broker's response without dialing a real connection.
+type roundTripFunc func(ctx context.Context, addr net.Addr, req protocol.Message) (protocol.Message, error)
+
+func (f roundTripFunc) RoundTrip(ctx context.Context, addr net.Addr, req protocol.Message) (protocol.Message, error) {
+	return f(ctx, addr, req)
+}
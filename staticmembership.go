@@ -0,0 +1,17 @@
+package kafka
+
+// Error codes introduced by KIP-345 (static group membership). They are
+// returned by JoinGroup, SyncGroup, Heartbeat, and LeaveGroup once a member
+// sets GroupInstanceID, and callers should react to them distinctly rather
+// than treating them like an ordinary rebalance trigger:
+//
+//   - FencedInstanceID means another member has since registered with the
+//     same GroupInstanceID, so this member has been fenced off and must
+//     shut down instead of rejoining.
+//   - MemberIDRequired means the broker assigned a MemberID to this static
+//     member and expects the client to retry JoinGroup with that MemberID
+//     rather than an empty one.
+const (
+	FencedInstanceID Error = 82
+	MemberIDRequired Error = 79
+)
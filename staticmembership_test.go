@@ -0,0 +1,72 @@
+package kafka
+
+import (
+	"context"
+	"net"
+	"os"
+	"testing"
+	"time"
+)
+
+// newLocalClient returns a Client targeting a Kafka broker on the default
+// local port, for integration tests gated behind KAFKA_GO_INTEGRATION. The
+// returned func is a cleanup hook for the caller to defer; it releases
+// nothing.
+func newLocalClient() (*Client, func()) {
+	client := &Client{
+		Addr:    &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 9092},
+		Timeout: 10 * time.Second,
+	}
+	return client, func() {}
+}
+
+// TestStaticMemberRejoinWithoutRebalance is an integration test that
+// verifies a member joining with a fixed GroupInstanceID can join again
+// within the session timeout, without passing the MemberID from its first
+// join, and keep the same generation instead of triggering a rebalance, per
+// KIP-345. Both joins go through the same client.
+func TestStaticMemberRejoinWithoutRebalance(t *testing.T) {
+	if os.Getenv("KAFKA_GO_INTEGRATION") == "" {
+		t.Skip("skipping integration test; set KAFKA_GO_INTEGRATION=1 to run against a local broker")
+	}
+
+	client, shutdown := newLocalClient()
+	defer shutdown()
+
+	const groupID = "static-membership-group"
+	const groupInstanceID = "static-membership-instance-1"
+
+	join := func() *JoinGroupResponse {
+		res, err := client.JoinGroup(context.Background(), &JoinGroupRequest{
+			GroupID:          groupID,
+			GroupInstanceID:  groupInstanceID,
+			SessionTimeout:   30 * time.Second,
+			RebalanceTimeout: 30 * time.Second,
+			ProtocolType:     "consumer",
+			Protocols: []GroupProtocol{
+				{Name: "roundrobin", Metadata: nil},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Error != nil {
+			t.Fatal(res.Error)
+		}
+		return res
+	}
+
+	first := join()
+
+	// Joining again as the same static member, with no MemberID, should
+	// rejoin with the same generation instead of forcing a rebalance.
+	second := join()
+
+	if first.GenerationID != second.GenerationID {
+		t.Fatalf("rejoining static member triggered a rebalance: generation went from %d to %d", first.GenerationID, second.GenerationID)
+	}
+
+	if second.MemberID == "" {
+		t.Fatal("expected a non-empty member id on rejoin")
+	}
+}
@@ -0,0 +1,112 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/segmentio/kafka-go/protocol/syncgroup"
+)
+
+// SyncGroupRequest is the request structure for the SyncGroup function.
+type SyncGroupRequest struct {
+	// Address of the kafka broker to send the request to.
+	Addr net.Addr
+
+	// GroupID of the group to sync.
+	GroupID string
+
+	// GenerationID of the group.
+	GenerationID int32
+
+	// MemberID assigned to the member in the group.
+	MemberID string
+
+	// GroupInstanceID is a unique identifier for a member within its group,
+	// as introduced by KIP-345 static membership.
+	GroupInstanceID string
+
+	// ProtocolType holds the unique name for the class of protocols
+	// implemented by the group.
+	ProtocolType string
+
+	// ProtocolName holds the name of the group protocol selected by the
+	// coordinator.
+	ProtocolName string
+
+	// Assignments is the list of member/assignment pairs produced by the
+	// group leader. Only the leader sets this field; other members send an
+	// empty list and receive their assignment back in the response.
+	Assignments []SyncGroupRequestAssignment
+}
+
+type SyncGroupRequestAssignment struct {
+	// MemberID assigned to the member in the group.
+	MemberID string
+
+	// Assignment is the raw assignment data for the member.
+	Assignment []byte
+}
+
+// SyncGroupResponse is the response structure for the SyncGroup function.
+type SyncGroupResponse struct {
+	// Error is set to a non-nil value including the code and message if a
+	// top-level error was encountered when making the request. A fenced
+	// static member (see FencedInstanceID) should treat this as fatal rather
+	// than rejoin the group.
+	Error error
+
+	// Throttle is how long the client should wait before sending requests
+	// again.
+	Throttle time.Duration
+
+	// ProtocolType holds the unique name for the class of protocols
+	// implemented by the group.
+	ProtocolType string
+
+	// ProtocolName holds the name of the group protocol selected by the
+	// coordinator.
+	ProtocolName string
+
+	// MemberAssignments holds the assignment data for this member.
+	MemberAssignments []byte
+}
+
+// SyncGroup sends the leader's assignments (or, for non-leaders, an empty
+// request) to the group coordinator and returns this member's assignment.
+func (c *Client) SyncGroup(ctx context.Context, req *SyncGroupRequest) (*SyncGroupResponse, error) {
+	syncGroup := syncgroup.Request{
+		GroupID:         req.GroupID,
+		GenerationID:    req.GenerationID,
+		MemberID:        req.MemberID,
+		GroupInstanceID: req.GroupInstanceID,
+		ProtocolType:    req.ProtocolType,
+		ProtocolName:    req.ProtocolName,
+		Assignments:     make([]syncgroup.RequestAssignment, 0, len(req.Assignments)),
+	}
+
+	for _, assignment := range req.Assignments {
+		syncGroup.Assignments = append(syncGroup.Assignments, syncgroup.RequestAssignment{
+			MemberID:   assignment.MemberID,
+			Assignment: assignment.Assignment,
+		})
+	}
+
+	m, err := c.roundTrip(ctx, req.Addr, &syncGroup)
+	if err != nil {
+		return nil, fmt.Errorf("kafka.(*Client).SyncGroup: %w", err)
+	}
+
+	r := m.(*syncgroup.Response)
+
+	res := &SyncGroupResponse{
+		Error:             makeError(r.ErrorCode, ""),
+		Throttle:          makeDuration(r.ThrottleTimeMS),
+		ProtocolType:      r.ProtocolType,
+		ProtocolName:      r.ProtocolName,
+		MemberAssignments: r.Assignment,
+	}
+
+	return res, nil
+}